@@ -8,6 +8,8 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"extend-custom-guild-service/pkg/economy"
+	"extend-custom-guild-service/pkg/purchase"
 	"extend-custom-guild-service/pkg/service"
 	"extend-custom-guild-service/pkg/storage"
 	"fmt"
@@ -25,6 +27,7 @@ import (
 	"github.com/go-openapi/loads"
 
 	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/cloudsave"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/platform"
 
 	"extend-custom-guild-service/pkg/common"
 
@@ -142,6 +145,66 @@ func main() {
 		logger.Info("added auth interceptors")
 	}
 
+	// Initialize the energy storage backend
+	var energyStorage storage.Storage
+	var ledgerStore storage.LedgerStore
+	var purchaseVerifier purchase.Verifier
+	storageBackend := strings.ToLower(common.GetEnv("STORAGE_BACKEND", "cloudsave"))
+	switch storageBackend {
+	case "memory":
+		// In-memory storage needs no AGS credentials, for local development and CI
+		energyStorage = storage.NewMemoryStorage()
+		ledgerStore = storage.NewMemoryLedgerStore()
+		logger.Warn("using in-memory storage, energy data will be lost on restart")
+		logger.Warn("purchase verification needs AGS credentials, purchase refills are rejected")
+	case "cloudsave":
+		// Configure IAM authorization
+		clientId := configRepo.GetClientId()
+		clientSecret := configRepo.GetClientSecret()
+		err := oauthService.LoginClient(&clientId, &clientSecret)
+		if err != nil {
+			logger.Error("error unable to login using clientId and clientSecret", "error", err)
+			os.Exit(1)
+		}
+
+		// Initialize the AccelByte CloudSave service
+		adminGameRecordService := cloudsave.AdminGameRecordService{
+			Client:          factory.NewCloudsaveClient(configRepo),
+			TokenRepository: tokenRepo,
+		}
+
+		adminConcurrentRecordService := cloudsave.AdminConcurrentRecordService{
+			Client:          factory.NewCloudsaveClient(configRepo),
+			TokenRepository: tokenRepo,
+		}
+
+		energyStorage = storage.NewCloudSaveStorage(&adminGameRecordService, &adminConcurrentRecordService)
+		ledgerStore = storage.NewCloudSaveLedgerStore(&adminGameRecordService, &adminConcurrentRecordService)
+
+		// Verify purchase refills against AGS Platform entitlements
+		purchaseVerifier = purchase.NewPlatformVerifier(&platform.EntitlementService{
+			Client:          factory.NewPlatformClient(configRepo),
+			TokenRepository: tokenRepo,
+		})
+	default:
+		logger.Error("unknown storage backend", "STORAGE_BACKEND", storageBackend)
+		os.Exit(1)
+	}
+
+	// Load the economy config (action costs, refill sources, loot tables) and reload it when the file changes
+	economyConfigPath := common.GetEnv("ECONOMY_CONFIG_PATH", "config/economy.yaml")
+	economyProvider, err := economy.NewFileProvider(economyConfigPath)
+	if err != nil {
+		logger.Error("unable to load economy config", "path", economyConfigPath, "error", err)
+		os.Exit(1)
+	}
+	economyReloadInterval := time.Duration(common.GetEnvInt("ECONOMY_CONFIG_RELOAD_INTERVAL_SECONDS", 10)) * time.Second
+	go economyProvider.Watch(ctx, economyReloadInterval)
+	logger.Info("loaded economy config", "path", economyConfigPath, "version", economyProvider.Current().Version)
+
+	// Replay stored responses for mutating requests repeated with the same Idempotency-Key
+	unaryServerInterceptors = append(unaryServerInterceptors, common.NewUnaryIdempotencyServerIntercept(energyStorage))
+
 	// Create gRPC Server
 	s := grpc.NewServer(
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
@@ -149,25 +212,19 @@ func main() {
 		grpc.ChainStreamInterceptor(streamServerInterceptors...),
 	)
 
-	// Configure IAM authorization
-	clientId := configRepo.GetClientId()
-	clientSecret := configRepo.GetClientSecret()
-	err := oauthService.LoginClient(&clientId, &clientSecret)
-	if err != nil {
-		logger.Error("error unable to login using clientId and clientSecret", "error", err)
-		os.Exit(1)
+	// Register Energy Service
+	serviceOptions := []service.Option{service.WithLedgerStore(ledgerStore)}
+	if purchaseVerifier != nil {
+		serviceOptions = append(serviceOptions, service.WithPurchaseVerifier(purchaseVerifier))
 	}
-
-	// Initialize the AccelByte CloudSave service
-	adminGameRecordService := cloudsave.AdminGameRecordService{
-		Client:          factory.NewCloudsaveClient(configRepo),
-		TokenRepository: tokenRepo,
+	if strings.ToLower(common.GetEnv("ENERGY_DEBUG_TIME_OFFSET_ENABLED", "false")) == "true" {
+		// Lets admins fast-forward a namespace's clock, never enable in production
+		logger.Warn("debug time offsets are enabled")
+		serviceOptions = append(serviceOptions, service.WithDebugTimeOffset())
 	}
-
-	cloudSaveStorage := storage.NewCloudSaveStorage(&adminGameRecordService)
-
-	// Register Energy Service
-	energyServiceServer := service.NewEnergyServiceServer(tokenRepo, configRepo, refreshRepo, cloudSaveStorage)
+	energyServiceServer := service.NewEnergyServiceServer(
+		tokenRepo, configRepo, refreshRepo, energyStorage, economyProvider, serviceOptions...,
+	)
 	pb.RegisterServiceServer(s, energyServiceServer)
 
 	// Enable gRPC Reflection
@@ -203,6 +260,7 @@ func main() {
 		prometheusCollectors.NewProcessCollector(prometheusCollectors.ProcessCollectorOpts{}),
 		prometheusGrpc.DefaultServerMetrics,
 	)
+	prometheusRegistry.MustRegister(economyProvider.Collectors()...)
 
 	go func() {
 		http.Handle(metricsEndpoint, promhttp.HandlerFor(prometheusRegistry, promhttp.HandlerOpts{}))
@@ -7,6 +7,7 @@ package common
 import (
 	"context"
 	"net/http"
+	"strings"
 
 	"google.golang.org/grpc/credentials/insecure"
 
@@ -22,7 +23,7 @@ type Gateway struct {
 }
 
 func NewGateway(ctx context.Context, grpcServerEndpoint string, basePath string) (*Gateway, error) {
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher))
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
 	err := pb.RegisterServiceHandlerFromEndpoint(ctx, mux, grpcServerEndpoint, opts)
 	if err != nil {
@@ -35,6 +36,15 @@ func NewGateway(ctx context.Context, grpcServerEndpoint string, basePath string)
 	}, nil
 }
 
+// incomingHeaderMatcher forwards the Idempotency-Key header as gRPC metadata, on top of the default headers
+func incomingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, IdempotencyKeyHeader) {
+		return IdempotencyKeyHeader, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
 func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// Strip the base path, since the base_path configuration in protofile won't actually do the routing
 	// Reference: https://github.com/grpc-ecosystem/grpc-gateway/pull/919/commits/1c34df861cfc0d6cb19ea617921d7d9eaa209977
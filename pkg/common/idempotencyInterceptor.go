@@ -0,0 +1,226 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package common
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	pb "extend-custom-guild-service/pkg/pb"
+	"extend-custom-guild-service/pkg/storage"
+)
+
+// IdempotencyKeyHeader is the gRPC metadata key (and HTTP header) carrying the client's idempotency key
+const IdempotencyKeyHeader = "idempotency-key"
+
+// How long a stored response is replayed for a repeated key
+const idempotencyKeyRetention = 24 * time.Hour
+
+// How long a claimed key blocks duplicates while its request is running.
+// A claim left behind by a crashed instance frees the key after this.
+const idempotencyClaimTimeout = time.Minute
+
+// idempotentRequest is implemented by every request message that targets a player
+type idempotentRequest interface {
+	proto.Message
+	GetNamespace() string
+	GetUserId() string
+}
+
+// successResponse is implemented by response messages reporting whether the request was applied
+type successResponse interface {
+	GetSuccess() bool
+}
+
+func findMethodDescriptor(fullMethod string) (protoreflect.MethodDescriptor, error) {
+	serviceName, methodName, err := parseFullMethod(fullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(serviceName))
+	if err != nil {
+		return nil, err
+	}
+
+	serviceDesc, ok := desc.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, errors.New("invalid FullMethod format: not a service")
+	}
+
+	method := serviceDesc.Methods().ByName(protoreflect.Name(methodName))
+	if method == nil {
+		return nil, errors.New("invalid FullMethod format: unknown method")
+	}
+
+	return method, nil
+}
+
+// isMutatingMethod reports whether the method's permission.action changes data
+func isMutatingMethod(method protoreflect.MethodDescriptor) bool {
+	action, ok := proto.GetExtension(method.Options(), pb.E_Action).(pb.Action)
+
+	return ok && action != pb.Action_unknown && action != pb.Action_READ
+}
+
+func extractIdempotencyKey(ctx context.Context) string {
+	meta, found := metadata.FromIncomingContext(ctx)
+	if !found {
+		return ""
+	}
+
+	values := meta.Get(IdempotencyKeyHeader)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+func hashRequest(req proto.Message) (string, error) {
+	reqBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(reqBytes)
+
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// replayResponse decodes a stored response into a new message of the method's output type
+func replayResponse(method protoreflect.MethodDescriptor, record *storage.IdempotencyRecord) (interface{}, error) {
+	responseType, err := protoregistry.GlobalTypes.FindMessageByName(method.Output().FullName())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to replay idempotent response: %v", err)
+	}
+
+	response := responseType.New().Interface()
+	if err := proto.Unmarshal(record.Response, response); err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to replay idempotent response: %v", err)
+	}
+
+	return response, nil
+}
+
+// NewUnaryIdempotencyServerIntercept replays the stored response when a mutating request is
+// repeated with the same Idempotency-Key by the same player. Reusing a key with a different
+// request body or method is rejected. The key is claimed in storage before the request runs,
+// so a duplicate arriving while it is still running on any instance is rejected with Aborted.
+// Requests without the key are passed through unchanged.
+func NewUnaryIdempotencyServerIntercept(energyStorage storage.Storage) func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) { // nolint
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		key := extractIdempotencyKey(ctx)
+		if key == "" {
+			return handler(ctx, req)
+		}
+
+		playerReq, ok := req.(idempotentRequest)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		method, err := findMethodDescriptor(info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		if !isMutatingMethod(method) {
+			return handler(ctx, req)
+		}
+
+		requestHash, err := hashRequest(playerReq)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to hash request: %v", err)
+		}
+
+		namespace, userId := playerReq.GetNamespace(), playerReq.GetUserId()
+		now := time.Now()
+		record, err := energyStorage.GetIdempotencyRecord(ctx, namespace, userId, key)
+		if err != nil && !errors.Is(err, storage.ErrNotFound) {
+			// Fail closed, processing again could apply the request twice
+			return nil, err
+		}
+
+		if record != nil && record.ExpiresAt > now.Unix() {
+			if record.Method != info.FullMethod || record.RequestHash != requestHash {
+				return nil, status.Errorf(codes.AlreadyExists,
+					"Idempotency-Key %s was already used for a different request", key)
+			}
+
+			if record.InProgress {
+				return nil, status.Errorf(codes.Aborted,
+					"A request with Idempotency-Key %s is still in progress", key)
+			}
+
+			return replayResponse(method, record)
+		}
+
+		claim := &storage.IdempotencyRecord{
+			Method:      info.FullMethod,
+			RequestHash: requestHash,
+			CreatedAt:   now.Unix(),
+			ExpiresAt:   now.Add(idempotencyClaimTimeout).Unix(),
+			InProgress:  true,
+		}
+		if record != nil {
+			// Replace the expired record CloudSave has not deleted yet
+			claim.UpdatedAt = record.UpdatedAt
+		}
+		err = energyStorage.ClaimIdempotencyRecord(ctx, namespace, userId, key, claim)
+		if errors.Is(err, storage.ErrConflict) {
+			return nil, status.Errorf(codes.Aborted,
+				"A request with Idempotency-Key %s is still in progress", key)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := handler(ctx, req)
+
+		// Errors and requests that were not applied, e.g. for lack of energy, are not stored.
+		// Release the key so a retry with it runs again once the condition has cleared.
+		if result, ok := resp.(successResponse); err != nil || (ok && !result.GetSuccess()) {
+			if deleteErr := energyStorage.DeleteIdempotencyRecord(ctx, namespace, userId, key); deleteErr != nil {
+				slog.Warn("failed to release idempotency key", "method", info.FullMethod, "error", deleteErr)
+			}
+
+			return resp, err
+		}
+
+		respMessage, ok := resp.(proto.Message)
+		if !ok {
+			return resp, nil
+		}
+
+		respBytes, err := proto.Marshal(respMessage)
+		if err == nil {
+			err = energyStorage.SaveIdempotencyRecord(ctx, namespace, userId, key, &storage.IdempotencyRecord{
+				Method:      info.FullMethod,
+				RequestHash: requestHash,
+				Response:    respBytes,
+				CreatedAt:   now.Unix(),
+				ExpiresAt:   now.Add(idempotencyKeyRetention).Unix(),
+			})
+		}
+		if err != nil {
+			// The request was already applied, so return its response rather than an error.
+			// The claim keeps rejecting duplicates until it expires.
+			slog.Warn("failed to store idempotency record", "method", info.FullMethod, "error", err)
+		}
+
+		return resp, nil
+	}
+}
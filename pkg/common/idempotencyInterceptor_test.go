@@ -0,0 +1,130 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "extend-custom-guild-service/pkg/pb"
+	"extend-custom-guild-service/pkg/storage"
+)
+
+var consumeInfo = &grpc.UnaryServerInfo{FullMethod: pb.Service_ConsumeMyEnergy_FullMethodName}
+
+// withIdempotencyKey returns a context carrying key like a client request
+func withIdempotencyKey(key string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(IdempotencyKeyHeader, key))
+}
+
+func consumeRequest(actionType string) *pb.ConsumeMyEnergyRequest {
+	return &pb.ConsumeMyEnergyRequest{Namespace: "test", UserId: "player", ActionType: actionType}
+}
+
+// countingHandler answers every call with its number and the given outcome
+func countingHandler(calls *int, success bool, err error) grpc.UnaryHandler {
+	return func(_ context.Context, _ interface{}) (interface{}, error) {
+		*calls++
+		if err != nil {
+			return nil, err
+		}
+
+		return &pb.ConsumeEnergyResponse{Success: success, Message: fmt.Sprintf("call %d", *calls)}, nil
+	}
+}
+
+func TestIdempotencyReplay(t *testing.T) {
+	intercept := NewUnaryIdempotencyServerIntercept(storage.NewMemoryStorage())
+	ctx := withIdempotencyKey("key")
+
+	var calls int
+	handler := countingHandler(&calls, true, nil)
+	if _, err := intercept(ctx, consumeRequest("fight"), consumeInfo, handler); err != nil {
+		t.Fatalf("first request: %v", err)
+	}
+
+	resp, err := intercept(ctx, consumeRequest("fight"), consumeInfo, handler)
+	if err != nil {
+		t.Fatalf("repeated request: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("handler calls = %d, want 1", calls)
+	}
+	if message := resp.(*pb.ConsumeEnergyResponse).Message; message != "call 1" {
+		t.Errorf("replayed Message = %q, want %q", message, "call 1")
+	}
+
+	// The same key with another body is rejected
+	_, err = intercept(ctx, consumeRequest("explore"), consumeInfo, handler)
+	if status.Code(err) != codes.AlreadyExists {
+		t.Errorf("different request error = %v, want AlreadyExists", err)
+	}
+	if calls != 1 {
+		t.Errorf("handler calls = %d, want 1", calls)
+	}
+}
+
+func TestIdempotencyInProgress(t *testing.T) {
+	intercept := NewUnaryIdempotencyServerIntercept(storage.NewMemoryStorage())
+	ctx := withIdempotencyKey("key")
+
+	// The duplicate arrives while the first request is still running
+	var duplicateErr error
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		_, duplicateErr = intercept(ctx, req, consumeInfo, countingHandler(new(int), true, nil))
+		return &pb.ConsumeEnergyResponse{Success: true}, nil
+	}
+	if _, err := intercept(ctx, consumeRequest("fight"), consumeInfo, handler); err != nil {
+		t.Fatalf("first request: %v", err)
+	}
+	if status.Code(duplicateErr) != codes.Aborted {
+		t.Errorf("duplicate error = %v, want Aborted", duplicateErr)
+	}
+}
+
+func TestIdempotencyReleasesUnappliedRequests(t *testing.T) {
+	tests := []struct {
+		name    string
+		success bool
+		err     error
+	}{
+		{
+			name: "error",
+			err:  status.Errorf(codes.Unavailable, "CloudSave is unavailable"),
+		},
+		{
+			name:    "not applied",
+			success: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			intercept := NewUnaryIdempotencyServerIntercept(storage.NewMemoryStorage())
+			ctx := withIdempotencyKey("key")
+
+			var calls int
+			_, err := intercept(ctx, consumeRequest("fight"), consumeInfo, countingHandler(&calls, tt.success, tt.err))
+			if status.Code(err) != status.Code(tt.err) {
+				t.Fatalf("first request error = %v, want %v", err, tt.err)
+			}
+
+			// The retry runs again once the condition has cleared
+			resp, err := intercept(ctx, consumeRequest("fight"), consumeInfo, countingHandler(&calls, true, nil))
+			if err != nil {
+				t.Fatalf("retried request: %v", err)
+			}
+			if calls != 2 || !resp.(*pb.ConsumeEnergyResponse).Success {
+				t.Errorf("handler calls, Success = %d, %v, want 2, true", calls, resp.(*pb.ConsumeEnergyResponse).Success)
+			}
+		})
+	}
+}
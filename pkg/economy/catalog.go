@@ -0,0 +1,148 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package economy
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Rarities an item may have, from most to least common
+var Rarities = []string{"common", "uncommon", "rare", "epic", "legendary"}
+
+// Item defines an item of the catalog that can be granted to a player
+type Item struct {
+	Name           string            `json:"name" yaml:"name"`                     // Default display name
+	LocalizedNames map[string]string `json:"localizedNames" yaml:"localizedNames"` // language -> display name, e.g. "id" or "pt-BR"
+	Rarity         string            `json:"rarity" yaml:"rarity"`                 // One of Rarities, default common
+	Category       string            `json:"category" yaml:"category"`             // e.g. currency, material
+	IconKey        string            `json:"iconKey" yaml:"iconKey"`               // Client asset key of the icon
+	MaxStack       int32             `json:"maxStack" yaml:"maxStack"`             // Most a player may hold, 0 = unlimited
+	Tradable       bool              `json:"tradable" yaml:"tradable"`
+	Sellable       bool              `json:"sellable" yaml:"sellable"`
+	ConvertValue   int32             `json:"convertValue" yaml:"convertValue"` // Convert items paid per unit that does not fit, 0 = discarded
+}
+
+// LocalizedName returns the display name of the item in language, falling back from a regional
+// language to its base language (pt-BR to pt) and then to the default name
+func (i Item) LocalizedName(language string) string {
+	if name, ok := i.LocalizedNames[language]; ok {
+		return name
+	}
+	if base, _, regional := strings.Cut(language, "-"); regional {
+		if name, ok := i.LocalizedNames[base]; ok {
+			return name
+		}
+	}
+
+	return i.Name
+}
+
+// RarityOrDefault returns the rarity of the item, common when not set
+func (i Item) RarityOrDefault() string {
+	if i.Rarity == "" {
+		return Rarities[0]
+	}
+
+	return i.Rarity
+}
+
+// validate checks the catalog entry of an item
+func (i Item) validate(itemId string) []error {
+	var errs []error
+	if i.Name == "" {
+		errs = append(errs, fmt.Errorf("item %s: name is required", itemId))
+	}
+	for language, name := range i.LocalizedNames {
+		if name == "" {
+			errs = append(errs, fmt.Errorf("item %s: localized name %s must not be empty", itemId, language))
+		}
+	}
+	if i.Rarity != "" && !isRarity(i.Rarity) {
+		errs = append(errs, fmt.Errorf("item %s: rarity must be one of %s, got %q",
+			itemId, strings.Join(Rarities, ", "), i.Rarity))
+	}
+	if i.MaxStack < 0 {
+		errs = append(errs, fmt.Errorf("item %s: maxStack must not be negative, got %d", itemId, i.MaxStack))
+	}
+	if i.ConvertValue < 0 {
+		errs = append(errs, fmt.Errorf("item %s: convertValue must not be negative, got %d", itemId, i.ConvertValue))
+	}
+
+	return errs
+}
+
+// isRarity reports whether rarity is one of Rarities
+func isRarity(rarity string) bool {
+	for _, known := range Rarities {
+		if rarity == known {
+			return true
+		}
+	}
+
+	return false
+}
+
+// StackLimit returns the most of an item a player may hold, max stack or the int32 limit
+func (c *Config) StackLimit(itemId string) int32 {
+	if item, ok := c.Items[itemId]; ok && item.MaxStack > 0 {
+		return item.MaxStack
+	}
+
+	return math.MaxInt32
+}
+
+// Item returns the catalog entry of an item
+func (c *Config) Item(itemId string) (Item, bool) {
+	item, ok := c.Items[itemId]
+
+	return item, ok
+}
+
+// ItemName returns the display name of an item, or the item ID when it is not in the catalog
+func (c *Config) ItemName(itemId string) string {
+	return c.LocalizedItemName(itemId, "")
+}
+
+// LocalizedItemName returns the display name of an item in language, or the item ID when it is not in the catalog
+func (c *Config) LocalizedItemName(itemId string, language string) string {
+	if item, ok := c.Items[itemId]; ok {
+		return item.LocalizedName(language)
+	}
+
+	return itemId
+}
+
+// ItemIDs returns the ID of every catalog item in alphabetical order
+func (c *Config) ItemIDs() []string {
+	itemIds := make([]string, 0, len(c.Items))
+	for itemId := range c.Items {
+		itemIds = append(itemIds, itemId)
+	}
+	sort.Strings(itemIds)
+
+	return itemIds
+}
+
+// validateItemQuantities checks quantities is not empty and only has positive quantities of catalog items
+func (c *Config) validateItemQuantities(what string, quantities map[string]int32) []error {
+	if len(quantities) == 0 {
+		return []error{fmt.Errorf("%s is required", what)}
+	}
+
+	var errs []error
+	for itemId, qty := range quantities {
+		if _, ok := c.Items[itemId]; !ok {
+			errs = append(errs, fmt.Errorf("%s: unknown item %q", what, itemId))
+		}
+		if qty <= 0 {
+			errs = append(errs, fmt.Errorf("%s quantity of %s must be positive, got %d", what, itemId, qty))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,478 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package economy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	// Refill policy time zones must resolve in containers without a zoneinfo database
+	_ "time/tzdata"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultPoolID is the energy pool every player has. Its limits come from the namespace
+// energy config and the player's energy level, other pools are defined in Config.Pools.
+const DefaultPoolID = "energy"
+
+// Pool defines an additional energy pool, e.g. tickets for PvP or keys for dungeons
+type Pool struct {
+	Name             string `json:"name" yaml:"name"`
+	MaxEnergy        int32  `json:"maxEnergy" yaml:"maxEnergy"`
+	RegenRateSeconds int32  `json:"regenRateSeconds" yaml:"regenRateSeconds"` // Seconds per point
+	StartingEnergy   int32  `json:"startingEnergy" yaml:"startingEnergy"`
+}
+
+// PoolAmounts maps pool ID to an amount of energy.
+// In the config file a plain number is shorthand for an amount of the default pool.
+type PoolAmounts map[string]int32
+
+// UnmarshalJSON accepts either a number or an object of pool ID to number
+func (a *PoolAmounts) UnmarshalJSON(content []byte) error {
+	var amount int32
+	if err := json.Unmarshal(content, &amount); err == nil {
+		*a = PoolAmounts{DefaultPoolID: amount}
+		return nil
+	}
+
+	var amounts map[string]int32
+	if err := json.Unmarshal(content, &amounts); err != nil {
+		return err
+	}
+	*a = amounts
+
+	return nil
+}
+
+// UnmarshalYAML accepts either a number or a mapping of pool ID to number
+func (a *PoolAmounts) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		var amount int32
+		if err := node.Decode(&amount); err != nil {
+			return err
+		}
+		*a = PoolAmounts{DefaultPoolID: amount}
+
+		return nil
+	}
+
+	var amounts map[string]int32
+	if err := node.Decode(&amounts); err != nil {
+		return err
+	}
+	*a = amounts
+
+	return nil
+}
+
+// PoolIDs returns the pool IDs in a stable order, the default pool first
+func (a PoolAmounts) PoolIDs() []string {
+	poolIds := make([]string, 0, len(a))
+	for poolId := range a {
+		poolIds = append(poolIds, poolId)
+	}
+	sortPoolIDs(poolIds)
+
+	return poolIds
+}
+
+// sortPoolIDs sorts pool IDs alphabetically with the default pool first
+func sortPoolIDs(poolIds []string) {
+	sort.Slice(poolIds, func(i, j int) bool {
+		if (poolIds[i] == DefaultPoolID) != (poolIds[j] == DefaultPoolID) {
+			return poolIds[i] == DefaultPoolID
+		}
+
+		return poolIds[i] < poolIds[j]
+	})
+}
+
+// LootEntry defines a possible loot drop
+type LootEntry struct {
+	ItemID string `json:"itemId" yaml:"itemId"`
+	MinQty int32  `json:"minQty" yaml:"minQty"`
+	MaxQty int32  `json:"maxQty" yaml:"maxQty"`
+	Weight int    `json:"weight" yaml:"weight"` // Higher weight = more common
+}
+
+// RefillPolicy limits how often players may refill energy from a source themselves.
+// Admin refills are not limited.
+type RefillPolicy struct {
+	CooldownSeconds int64  `json:"cooldownSeconds" yaml:"cooldownSeconds"` // Between two refills, 0 = no cooldown
+	MaxPerDay       int32  `json:"maxPerDay" yaml:"maxPerDay"`             // Refills per day, 0 = unlimited
+	ResetHour       int    `json:"resetHour" yaml:"resetHour"`             // Hour of the day the daily uses reset, 0-23
+	TimeZone        string `json:"timeZone" yaml:"timeZone"`               // IANA time zone of ResetHour, default UTC
+	// Namespaces players may not refill from the source in, "*" for every namespace
+	DisabledNamespaces []string `json:"disabledNamespaces" yaml:"disabledNamespaces"`
+}
+
+// Disabled reports whether players may not refill from the source in namespace
+func (p RefillPolicy) Disabled(namespace string) bool {
+	for _, disabled := range p.DisabledNamespaces {
+		if disabled == "*" || disabled == namespace {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DayStart returns when the policy day containing now started, i.e. the last daily reset
+func (p RefillPolicy) DayStart(now time.Time) time.Time {
+	location, err := p.location()
+	if err != nil {
+		// Validate rejects unknown time zones
+		location = time.UTC
+	}
+
+	local := now.In(location)
+	start := time.Date(local.Year(), local.Month(), local.Day(), p.ResetHour, 0, 0, 0, location)
+	if start.After(local) {
+		start = start.AddDate(0, 0, -1)
+	}
+
+	return start
+}
+
+// validate checks the limits of a policy, what naming it in errors
+func (p RefillPolicy) validate(what string) []error {
+	var errs []error
+	if p.CooldownSeconds < 0 {
+		errs = append(errs, fmt.Errorf("%s: cooldownSeconds must not be negative, got %d", what, p.CooldownSeconds))
+	}
+	if p.MaxPerDay < 0 {
+		errs = append(errs, fmt.Errorf("%s: maxPerDay must not be negative, got %d", what, p.MaxPerDay))
+	}
+	if p.ResetHour < 0 || p.ResetHour > 23 {
+		errs = append(errs, fmt.Errorf("%s: resetHour must be between 0 and 23, got %d", what, p.ResetHour))
+	}
+	if _, err := p.location(); err != nil {
+		errs = append(errs, fmt.Errorf("%s: unknown timeZone %q", what, p.TimeZone))
+	}
+
+	return errs
+}
+
+// location returns the time zone of the daily reset
+func (p RefillPolicy) location() (*time.Location, error) {
+	if p.TimeZone == "" {
+		return time.UTC, nil
+	}
+
+	return time.LoadLocation(p.TimeZone)
+}
+
+// Level defines the energy limits a player gets at an energy level
+type Level struct {
+	Level               int32            `json:"level" yaml:"level"`
+	MaxEnergy           int32            `json:"maxEnergy" yaml:"maxEnergy"`
+	RegenRateSeconds    int32            `json:"regenRateSeconds" yaml:"regenRateSeconds"`
+	CostDiscountPercent int32            `json:"costDiscountPercent" yaml:"costDiscountPercent"` // Applied to action costs
+	UpgradeCost         map[string]int32 `json:"upgradeCost" yaml:"upgradeCost"`                 // item_id -> quantity spent to reach this level
+}
+
+// Config is the server-authoritative game economy: what actions cost, what refills grant
+// and what actions drop
+type Config struct {
+	Version       string                 `json:"version" yaml:"version"`
+	Items         map[string]Item        `json:"items" yaml:"items"`                 // item_id -> item, the item catalog
+	Pools         map[string]Pool        `json:"pools" yaml:"pools"`                 // pool_id -> pool, besides the default pool
+	ActionCosts   map[string]PoolAmounts `json:"actionCosts" yaml:"actionCosts"`     // action_type -> energy cost per pool
+	RefillSources map[string]PoolAmounts `json:"refillSources" yaml:"refillSources"` // source -> energy granted per pool
+	Overflow      map[string]PoolAmounts `json:"overflow" yaml:"overflow"`           // source -> ceiling per pool it may refill above max
+	LootTables    map[string][]LootEntry `json:"lootTables" yaml:"lootTables"`       // action_type -> loot table
+	// source -> limits on player refills, sources without a policy are not limited
+	RefillPolicies map[string]RefillPolicy `json:"refillPolicies" yaml:"refillPolicies"`
+	// source -> item_id -> energy granted per pool, for refill sources backed by a verified purchase
+	PurchaseItems map[string]map[string]PoolAmounts `json:"purchaseItems" yaml:"purchaseItems"`
+	// Levels 2 and up in ascending order, level 1 uses the namespace energy config
+	Levels []Level `json:"levels" yaml:"levels"`
+	// recipe_id -> crafting recipe
+	Recipes map[string]Recipe `json:"recipes" yaml:"recipes"`
+	// offer_id -> items players may exchange for energy
+	ExchangeOffers map[string]ExchangeOffer `json:"exchangeOffers" yaml:"exchangeOffers"`
+	// Slot limit of the inventory and what happens to loot that does not fit
+	Inventory InventoryLimits `json:"inventory" yaml:"inventory"`
+}
+
+// Provider gives the service access to the active economy configuration
+type Provider interface {
+	Current() *Config
+}
+
+// StaticProvider always returns the same configuration
+type StaticProvider struct {
+	config *Config
+}
+
+// NewStaticProvider creates a provider for a fixed configuration
+func NewStaticProvider(config *Config) *StaticProvider {
+	return &StaticProvider{config: config}
+}
+
+// Current returns the configuration
+func (p *StaticProvider) Current() *Config {
+	return p.config
+}
+
+// Level returns the definition of an energy level, false for level 1 and unknown levels
+func (c *Config) Level(level int32) (*Level, bool) {
+	index := int(level) - 2
+	if index < 0 || index >= len(c.Levels) {
+		return nil, false
+	}
+
+	return &c.Levels[index], true
+}
+
+// MaxLevel returns the highest energy level a player can reach
+func (c *Config) MaxLevel() int32 {
+	return int32(len(c.Levels)) + 1
+}
+
+// HasPool reports whether poolId is the default pool or a configured pool
+func (c *Config) HasPool(poolId string) bool {
+	if poolId == DefaultPoolID {
+		return true
+	}
+	_, ok := c.Pools[poolId]
+
+	return ok
+}
+
+// PoolIDs returns every pool ID in a stable order, the default pool first
+func (c *Config) PoolIDs() []string {
+	poolIds := []string{DefaultPoolID}
+	for poolId := range c.Pools {
+		poolIds = append(poolIds, poolId)
+	}
+	sortPoolIDs(poolIds)
+
+	return poolIds
+}
+
+// OverflowCeiling returns how far a refill from source may raise a pool above its max energy,
+// false if refills from source are clamped to max energy
+func (c *Config) OverflowCeiling(source string, poolId string) (int32, bool) {
+	ceiling, ok := c.Overflow[source][poolId]
+
+	return ceiling, ok
+}
+
+// ActionCost returns the energy cost of an action per pool at an energy level, after the
+// level's discount. A discounted cost is always at least 1 energy.
+func (c *Config) ActionCost(actionType string, level int32) (PoolAmounts, bool) {
+	costs, ok := c.ActionCosts[actionType]
+	if !ok {
+		return nil, false
+	}
+
+	levelDef, found := c.Level(level)
+	discounted := make(PoolAmounts, len(costs))
+	for poolId, cost := range costs {
+		if found && levelDef.CostDiscountPercent > 0 {
+			cost -= cost * levelDef.CostDiscountPercent / 100
+			if cost < 1 {
+				cost = 1
+			}
+		}
+		discounted[poolId] = cost
+	}
+
+	return discounted, true
+}
+
+// LoadFile reads and validates an economy configuration.
+// Files ending in .yaml or .yml are parsed as YAML, anything else as JSON.
+func LoadFile(path string) (*Config, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read economy config: %w", err)
+	}
+
+	return Parse(content, filepath.Ext(path))
+}
+
+// Parse decodes and validates an economy configuration in the format given by its file extension
+func Parse(content []byte, ext string) (*Config, error) {
+	var config Config
+	var err error
+	switch strings.ToLower(ext) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(content, &config)
+	default:
+		err = json.Unmarshal(content, &config)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parse economy config: %w", err)
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid economy config %q: %w", config.Version, err)
+	}
+
+	return &config, nil
+}
+
+// Validate checks the configuration is consistent, reporting every problem found
+func (c *Config) Validate() error {
+	var errs []error
+
+	if c.Version == "" {
+		errs = append(errs, errors.New("version is required"))
+	}
+
+	for itemId, item := range c.Items {
+		errs = append(errs, item.validate(itemId)...)
+	}
+
+	for poolId, pool := range c.Pools {
+		if poolId == DefaultPoolID {
+			errs = append(errs, fmt.Errorf("pool %s: the default pool is configured by the namespace energy config", poolId))
+		}
+		if pool.Name == "" {
+			errs = append(errs, fmt.Errorf("pool %s: name is required", poolId))
+		}
+		if pool.MaxEnergy <= 0 {
+			errs = append(errs, fmt.Errorf("pool %s: maxEnergy must be positive, got %d", poolId, pool.MaxEnergy))
+		}
+		if pool.RegenRateSeconds <= 0 {
+			errs = append(errs, fmt.Errorf("pool %s: regenRateSeconds must be positive, got %d", poolId, pool.RegenRateSeconds))
+		}
+		if pool.StartingEnergy < 0 || pool.StartingEnergy > pool.MaxEnergy {
+			errs = append(errs, fmt.Errorf("pool %s: startingEnergy must be between 0 and maxEnergy, got %d",
+				poolId, pool.StartingEnergy))
+		}
+	}
+
+	if len(c.ActionCosts) == 0 {
+		errs = append(errs, errors.New("at least one action cost is required"))
+	}
+	for action, costs := range c.ActionCosts {
+		errs = append(errs, c.validatePoolAmounts("action "+action+": cost", costs)...)
+	}
+
+	for source, amounts := range c.RefillSources {
+		errs = append(errs, c.validatePoolAmounts("refill source "+source+": amount", amounts)...)
+	}
+
+	for source, ceilings := range c.Overflow {
+		errs = append(errs, c.validatePoolAmounts("overflow "+source+": ceiling", ceilings)...)
+		for poolId, ceiling := range ceilings {
+			if pool, ok := c.Pools[poolId]; ok && ceiling < pool.MaxEnergy {
+				errs = append(errs, fmt.Errorf("overflow %s: ceiling of pool %s must not be below its maxEnergy %d, got %d",
+					source, poolId, pool.MaxEnergy, ceiling))
+			}
+		}
+	}
+
+	for source, policy := range c.RefillPolicies {
+		if _, ok := c.RefillSources[source]; !ok {
+			errs = append(errs, fmt.Errorf("refill policy %s: unknown refill source", source))
+		}
+		errs = append(errs, policy.validate("refill policy "+source)...)
+	}
+
+	for source, items := range c.PurchaseItems {
+		if _, ok := c.RefillSources[source]; !ok {
+			errs = append(errs, fmt.Errorf("purchase items %s: unknown refill source", source))
+		}
+		if len(items) == 0 {
+			errs = append(errs, fmt.Errorf("purchase items %s: at least one item is required", source))
+		}
+		for itemId, amounts := range items {
+			errs = append(errs, c.validatePoolAmounts("purchase item "+source+"/"+itemId+": amount", amounts)...)
+		}
+	}
+
+	for action, table := range c.LootTables {
+		if _, ok := c.ActionCosts[action]; !ok {
+			errs = append(errs, fmt.Errorf("loot table %s: unknown action", action))
+		}
+		if len(table) == 0 {
+			errs = append(errs, fmt.Errorf("loot table %s: at least one entry is required", action))
+		}
+		for i, entry := range table {
+			if _, ok := c.Items[entry.ItemID]; !ok {
+				errs = append(errs, fmt.Errorf("loot table %s[%d]: unknown item %q", action, i, entry.ItemID))
+			}
+			if entry.Weight <= 0 {
+				errs = append(errs, fmt.Errorf("loot table %s[%d]: weight must be positive, got %d", action, i, entry.Weight))
+			}
+			if entry.MinQty <= 0 {
+				errs = append(errs, fmt.Errorf("loot table %s[%d]: minQty must be positive, got %d", action, i, entry.MinQty))
+			}
+			if entry.MinQty > entry.MaxQty {
+				errs = append(errs, fmt.Errorf("loot table %s[%d]: minQty %d is greater than maxQty %d",
+					action, i, entry.MinQty, entry.MaxQty))
+			}
+		}
+	}
+
+	for i, level := range c.Levels {
+		// Levels must be listed in order so Level can index them directly
+		if expected := int32(i) + 2; level.Level != expected {
+			errs = append(errs, fmt.Errorf("levels[%d]: expected level %d, got %d", i, expected, level.Level))
+		}
+		if level.MaxEnergy <= 0 {
+			errs = append(errs, fmt.Errorf("level %d: maxEnergy must be positive, got %d", level.Level, level.MaxEnergy))
+		}
+		if level.RegenRateSeconds <= 0 {
+			errs = append(errs, fmt.Errorf("level %d: regenRateSeconds must be positive, got %d",
+				level.Level, level.RegenRateSeconds))
+		}
+		if level.CostDiscountPercent < 0 || level.CostDiscountPercent >= 100 {
+			errs = append(errs, fmt.Errorf("level %d: costDiscountPercent must be between 0 and 99, got %d",
+				level.Level, level.CostDiscountPercent))
+		}
+		for itemId, qty := range level.UpgradeCost {
+			if _, ok := c.Items[itemId]; !ok {
+				errs = append(errs, fmt.Errorf("level %d: unknown upgrade item %q", level.Level, itemId))
+			}
+			if qty <= 0 {
+				errs = append(errs, fmt.Errorf("level %d: upgrade quantity of %s must be positive, got %d",
+					level.Level, itemId, qty))
+			}
+		}
+	}
+
+	for recipeId, recipe := range c.Recipes {
+		errs = append(errs, c.validateRecipe(recipeId, recipe)...)
+	}
+
+	for offerId, offer := range c.ExchangeOffers {
+		errs = append(errs, c.validateExchangeOffer(offerId, offer)...)
+	}
+
+	errs = append(errs, c.validateInventory()...)
+
+	return errors.Join(errs...)
+}
+
+// validatePoolAmounts checks amounts is not empty and only has positive amounts of known pools
+func (c *Config) validatePoolAmounts(what string, amounts PoolAmounts) []error {
+	if len(amounts) == 0 {
+		return []error{fmt.Errorf("%s is required", what)}
+	}
+
+	var errs []error
+	for poolId, amount := range amounts {
+		if !c.HasPool(poolId) {
+			errs = append(errs, fmt.Errorf("%s: unknown pool %q", what, poolId))
+		}
+		if amount <= 0 {
+			errs = append(errs, fmt.Errorf("%s of pool %s must be positive, got %d", what, poolId, amount))
+		}
+	}
+
+	return errs
+}
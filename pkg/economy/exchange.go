@@ -0,0 +1,42 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package economy
+
+import "fmt"
+
+// ExchangeSource is the refill source exchanges credit energy as, an overflow
+// ceiling for it lets exchanges refill above max energy
+const ExchangeSource = "exchange"
+
+// ExchangeOffer converts items into energy
+type ExchangeOffer struct {
+	Name   string           `json:"name" yaml:"name"`
+	Price  map[string]int32 `json:"price" yaml:"price"`   // item_id -> quantity spent
+	Energy PoolAmounts      `json:"energy" yaml:"energy"` // Energy credited per pool
+	// Cooldown, daily limit and disabled namespaces of the offer
+	RefillPolicy `yaml:",inline"`
+}
+
+// ExchangeOffer returns the exchange offer with the ID
+func (c *Config) ExchangeOffer(offerId string) (ExchangeOffer, bool) {
+	offer, ok := c.ExchangeOffers[offerId]
+
+	return offer, ok
+}
+
+// validateExchangeOffer checks an offer only uses catalog items and known pools
+func (c *Config) validateExchangeOffer(offerId string, offer ExchangeOffer) []error {
+	what := "exchange offer " + offerId
+
+	var errs []error
+	if offer.Name == "" {
+		errs = append(errs, fmt.Errorf("%s: name is required", what))
+	}
+	errs = append(errs, c.validateItemQuantities(what+": price", offer.Price)...)
+	errs = append(errs, c.validatePoolAmounts(what+": energy", offer.Energy)...)
+	errs = append(errs, offer.validate(what)...)
+
+	return errs
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package economy
+
+import "fmt"
+
+// What happens to loot that does not fit in the inventory
+const (
+	LootOverflowDiscard = "discard" // The loot is lost
+	LootOverflowConvert = "convert" // The loot is paid out in the convert item at its convertValue
+	LootOverflowPending = "pending" // The loot waits in the pending items until the player claims it
+)
+
+// DefaultConvertItemID is the item loot that does not fit is converted into
+const DefaultConvertItemID = "gold"
+
+// InventoryLimits bounds what a player may hold, besides the max stack of every item
+type InventoryLimits struct {
+	MaxSlots      int32  `json:"maxSlots" yaml:"maxSlots"`           // Different items a player may hold, 0 = unlimited
+	LootOverflow  string `json:"lootOverflow" yaml:"lootOverflow"`   // One of the LootOverflow modes, default discard
+	ConvertItemID string `json:"convertItemId" yaml:"convertItemId"` // Item loot is converted into, default gold
+}
+
+// LootOverflowMode returns what happens to loot that does not fit
+func (l InventoryLimits) LootOverflowMode() string {
+	if l.LootOverflow == "" {
+		return LootOverflowDiscard
+	}
+
+	return l.LootOverflow
+}
+
+// ConvertItem returns the item loot that does not fit is converted into
+func (l InventoryLimits) ConvertItem() string {
+	if l.ConvertItemID == "" {
+		return DefaultConvertItemID
+	}
+
+	return l.ConvertItemID
+}
+
+// validateInventory checks the inventory limits and the convert item
+func (c *Config) validateInventory() []error {
+	var errs []error
+	if c.Inventory.MaxSlots < 0 {
+		errs = append(errs, fmt.Errorf("inventory: maxSlots must not be negative, got %d", c.Inventory.MaxSlots))
+	}
+
+	switch mode := c.Inventory.LootOverflowMode(); mode {
+	case LootOverflowDiscard, LootOverflowPending:
+	case LootOverflowConvert:
+		if _, ok := c.Items[c.Inventory.ConvertItem()]; !ok {
+			errs = append(errs, fmt.Errorf("inventory: unknown convert item %q", c.Inventory.ConvertItem()))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("inventory: lootOverflow must be one of %s, %s or %s, got %q",
+			LootOverflowDiscard, LootOverflowConvert, LootOverflowPending, mode))
+	}
+
+	return errs
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package economy
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Recipe defines how items are crafted from other items
+type Recipe struct {
+	Name           string           `json:"name" yaml:"name"`
+	Inputs         map[string]int32 `json:"inputs" yaml:"inputs"`                 // item_id -> quantity spent
+	Outputs        map[string]int32 `json:"outputs" yaml:"outputs"`               // item_id -> quantity granted when crafting succeeds
+	EnergyCost     PoolAmounts      `json:"energyCost" yaml:"energyCost"`         // Energy spent per pool (optional)
+	SuccessPercent int32            `json:"successPercent" yaml:"successPercent"` // Chance to succeed, 0 = always succeeds
+	UnlockLevel    int32            `json:"unlockLevel" yaml:"unlockLevel"`       // Energy level required, 0 = any level
+}
+
+// SuccessChance returns the percent chance that crafting the recipe succeeds
+func (r Recipe) SuccessChance() int32 {
+	if r.SuccessPercent == 0 {
+		return 100
+	}
+
+	return r.SuccessPercent
+}
+
+// Unlocked reports whether a player at the energy level may craft the recipe
+func (r Recipe) Unlocked(level int32) bool {
+	return level >= r.UnlockLevel
+}
+
+// Recipe returns the crafting recipe with the ID
+func (c *Config) Recipe(recipeId string) (Recipe, bool) {
+	recipe, ok := c.Recipes[recipeId]
+
+	return recipe, ok
+}
+
+// RecipeIDs returns the ID of every recipe in alphabetical order
+func (c *Config) RecipeIDs() []string {
+	recipeIds := make([]string, 0, len(c.Recipes))
+	for recipeId := range c.Recipes {
+		recipeIds = append(recipeIds, recipeId)
+	}
+	sort.Strings(recipeIds)
+
+	return recipeIds
+}
+
+// validateRecipe checks a recipe only uses catalog items, known pools and reachable levels
+func (c *Config) validateRecipe(recipeId string, recipe Recipe) []error {
+	var errs []error
+	if recipe.Name == "" {
+		errs = append(errs, fmt.Errorf("recipe %s: name is required", recipeId))
+	}
+	errs = append(errs, c.validateItemQuantities("recipe "+recipeId+": input", recipe.Inputs)...)
+	errs = append(errs, c.validateItemQuantities("recipe "+recipeId+": output", recipe.Outputs)...)
+	if recipe.EnergyCost != nil {
+		errs = append(errs, c.validatePoolAmounts("recipe "+recipeId+": energy cost", recipe.EnergyCost)...)
+	}
+	if recipe.SuccessPercent < 0 || recipe.SuccessPercent > 100 {
+		errs = append(errs, fmt.Errorf("recipe %s: successPercent must be between 0 and 100, got %d",
+			recipeId, recipe.SuccessPercent))
+	}
+	if maxLevel := int32(len(c.Levels)) + 1; recipe.UnlockLevel < 0 || recipe.UnlockLevel > maxLevel {
+		errs = append(errs, fmt.Errorf("recipe %s: unlockLevel must be between 0 and %d, got %d",
+			recipeId, maxLevel, recipe.UnlockLevel))
+	}
+
+	return errs
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package economy
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// FileProvider serves the economy configuration from a file and reloads it when the file changes.
+// A changed file that fails validation is rejected and the previous configuration stays active.
+type FileProvider struct {
+	path    string
+	current atomic.Pointer[Config]
+	// SHA-256 of the last file content that was loaded or rejected, so it is parsed only once
+	lastHash [sha256.Size]byte
+
+	activeVersion *prometheus.GaugeVec
+	reloadFailed  prometheus.Gauge
+}
+
+// NewFileProvider loads the configuration at path, failing if it is missing or invalid
+func NewFileProvider(path string) (*FileProvider, error) {
+	p := &FileProvider{
+		path: path,
+		activeVersion: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "economy_config_active_version",
+			Help: "Economy config version currently in use, the active version has value 1",
+		}, []string{"version"}),
+		reloadFailed: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "economy_config_reload_failed",
+			Help: "1 if the economy config file on disk failed to load and the previous version is still active",
+		}),
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read economy config: %w", err)
+	}
+
+	config, err := Parse(content, filepath.Ext(path))
+	if err != nil {
+		return nil, err
+	}
+
+	p.lastHash = sha256.Sum256(content)
+	p.swap(config)
+
+	return p, nil
+}
+
+// Current returns the active configuration. Callers should read it once per request so a
+// reload in the middle of a request does not mix two versions.
+func (p *FileProvider) Current() *Config {
+	return p.current.Load()
+}
+
+// Collectors returns the Prometheus metrics describing the active configuration
+func (p *FileProvider) Collectors() []prometheus.Collector {
+	return []prometheus.Collector{p.activeVersion, p.reloadFailed}
+}
+
+// Watch checks the file for changes every interval until ctx is cancelled
+func (p *FileProvider) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.Reload()
+		}
+	}
+}
+
+// Reload loads the file if its content changed since the last attempt.
+// It must not be called concurrently with itself or Watch.
+func (p *FileProvider) Reload() {
+	content, err := os.ReadFile(p.path)
+	if err != nil {
+		// Keep serving the previous version, the file may be mid-replacement
+		slog.Error("failed to read economy config", "path", p.path, "error", err)
+		p.reloadFailed.Set(1)
+		p.lastHash = [sha256.Size]byte{}
+
+		return
+	}
+
+	hash := sha256.Sum256(content)
+	if hash == p.lastHash {
+		return
+	}
+	p.lastHash = hash
+
+	config, err := Parse(content, filepath.Ext(p.path))
+	if err != nil {
+		slog.Error("rejected economy config, keeping previous version",
+			"path", p.path, "activeVersion", p.Current().Version, "error", err)
+		p.reloadFailed.Set(1)
+
+		return
+	}
+
+	previous := p.Current()
+	p.swap(config)
+	slog.Info("reloaded economy config", "path", p.path, "previousVersion", previous.Version, "version", config.Version)
+}
+
+func (p *FileProvider) swap(config *Config) {
+	p.current.Store(config)
+	p.activeVersion.Reset()
+	p.activeVersion.WithLabelValues(config.Version).Set(1)
+	p.reloadFailed.Set(0)
+}
@@ -27,9 +27,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// How a batch behaves when energy runs out
+type BatchMode int32
+
+const (
+	BatchMode_ALL_OR_NOTHING BatchMode = 0 // Perform every action, or none if the total cost is not affordable
+	BatchMode_BEST_EFFORT    BatchMode = 1 // Perform as many actions as energy allows, in request order
+)
+
+// Enum value maps for BatchMode.
+var (
+	BatchMode_name = map[int32]string{
+		0: "ALL_OR_NOTHING",
+		1: "BEST_EFFORT",
+	}
+	BatchMode_value = map[string]int32{
+		"ALL_OR_NOTHING": 0,
+		"BEST_EFFORT":    1,
+	}
+)
+
+func (x BatchMode) Enum() *BatchMode {
+	p := new(BatchMode)
+	*p = x
+	return p
+}
+
+func (x BatchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_proto_enumTypes[0].Descriptor()
+}
+
+func (BatchMode) Type() protoreflect.EnumType {
+	return &file_service_proto_enumTypes[0]
+}
+
+func (x BatchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchMode.Descriptor instead.
+func (BatchMode) EnumDescriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{0}
+}
+
 type GetMyEnergyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PoolId        string                 `protobuf:"bytes,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"` // Optional energy pool, default "energy"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -71,12 +120,27 @@ func (x *GetMyEnergyRequest) GetNamespace() string {
 	return ""
 }
 
+func (x *GetMyEnergyRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetMyEnergyRequest) GetPoolId() string {
+	if x != nil {
+		return x.PoolId
+	}
+	return ""
+}
+
 type ConsumeMyEnergyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Amount        int32                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`                          // Energy to consume
-	ActionType    string                 `protobuf:"bytes,3,opt,name=action_type,json=actionType,proto3" json:"action_type,omitempty"` // Type: plant, harvest, visit, craft, explore, special
-	ActionId      string                 `protobuf:"bytes,4,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`       // Optional specific action ID for analytics
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount        int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`                          // Energy to consume
+	ActionType    string                 `protobuf:"bytes,4,opt,name=action_type,json=actionType,proto3" json:"action_type,omitempty"` // Type: plant, harvest, visit, craft, explore, special
+	ActionId      string                 `protobuf:"bytes,5,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`       // Optional specific action ID for analytics
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -118,6 +182,13 @@ func (x *ConsumeMyEnergyRequest) GetNamespace() string {
 	return ""
 }
 
+func (x *ConsumeMyEnergyRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
 func (x *ConsumeMyEnergyRequest) GetAmount() int32 {
 	if x != nil {
 		return x.Amount
@@ -139,31 +210,30 @@ func (x *ConsumeMyEnergyRequest) GetActionId() string {
 	return ""
 }
 
-type RefillMyEnergyRequest struct {
+type ConsumeMyEnergyBatchRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Amount        int32                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`                                   // Energy to add
-	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`                                    // Source: purchase, reward, levelup, daily, ad_watch, gift
-	ItemId        string                 `protobuf:"bytes,4,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`                      // Item ID if source is 'purchase'
-	TransactionId string                 `protobuf:"bytes,5,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"` // Transaction ID for validation
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Actions       []*BatchAction         `protobuf:"bytes,3,rep,name=actions,proto3" json:"actions,omitempty"` // Performed in order
+	Mode          BatchMode              `protobuf:"varint,4,opt,name=mode,proto3,enum=service.BatchMode" json:"mode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RefillMyEnergyRequest) Reset() {
-	*x = RefillMyEnergyRequest{}
+func (x *ConsumeMyEnergyBatchRequest) Reset() {
+	*x = ConsumeMyEnergyBatchRequest{}
 	mi := &file_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RefillMyEnergyRequest) String() string {
+func (x *ConsumeMyEnergyBatchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefillMyEnergyRequest) ProtoMessage() {}
+func (*ConsumeMyEnergyBatchRequest) ProtoMessage() {}
 
-func (x *RefillMyEnergyRequest) ProtoReflect() protoreflect.Message {
+func (x *ConsumeMyEnergyBatchRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -175,67 +245,61 @@ func (x *RefillMyEnergyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefillMyEnergyRequest.ProtoReflect.Descriptor instead.
-func (*RefillMyEnergyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConsumeMyEnergyBatchRequest.ProtoReflect.Descriptor instead.
+func (*ConsumeMyEnergyBatchRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *RefillMyEnergyRequest) GetNamespace() string {
+func (x *ConsumeMyEnergyBatchRequest) GetNamespace() string {
 	if x != nil {
 		return x.Namespace
 	}
 	return ""
 }
 
-func (x *RefillMyEnergyRequest) GetAmount() int32 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *RefillMyEnergyRequest) GetSource() string {
+func (x *ConsumeMyEnergyBatchRequest) GetUserId() string {
 	if x != nil {
-		return x.Source
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *RefillMyEnergyRequest) GetItemId() string {
+func (x *ConsumeMyEnergyBatchRequest) GetActions() []*BatchAction {
 	if x != nil {
-		return x.ItemId
+		return x.Actions
 	}
-	return ""
+	return nil
 }
 
-func (x *RefillMyEnergyRequest) GetTransactionId() string {
+func (x *ConsumeMyEnergyBatchRequest) GetMode() BatchMode {
 	if x != nil {
-		return x.TransactionId
+		return x.Mode
 	}
-	return ""
+	return BatchMode_ALL_OR_NOTHING
 }
 
-type GetMyEnergyConfigRequest struct {
+type BatchAction struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ActionType    string                 `protobuf:"bytes,1,opt,name=action_type,json=actionType,proto3" json:"action_type,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"` // Times to perform the action
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMyEnergyConfigRequest) Reset() {
-	*x = GetMyEnergyConfigRequest{}
+func (x *BatchAction) Reset() {
+	*x = BatchAction{}
 	mi := &file_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMyEnergyConfigRequest) String() string {
+func (x *BatchAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMyEnergyConfigRequest) ProtoMessage() {}
+func (*BatchAction) ProtoMessage() {}
 
-func (x *GetMyEnergyConfigRequest) ProtoReflect() protoreflect.Message {
+func (x *BatchAction) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -247,39 +311,49 @@ func (x *GetMyEnergyConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMyEnergyConfigRequest.ProtoReflect.Descriptor instead.
-func (*GetMyEnergyConfigRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchAction.ProtoReflect.Descriptor instead.
+func (*BatchAction) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetMyEnergyConfigRequest) GetNamespace() string {
+func (x *BatchAction) GetActionType() string {
 	if x != nil {
-		return x.Namespace
+		return x.ActionType
 	}
 	return ""
 }
 
-type GetMyInventoryRequest struct {
+func (x *BatchAction) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SpendMyItemsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Items         []*ItemQuantity        `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"` // What the items were spent on, recorded in the history (optional)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMyInventoryRequest) Reset() {
-	*x = GetMyInventoryRequest{}
+func (x *SpendMyItemsRequest) Reset() {
+	*x = SpendMyItemsRequest{}
 	mi := &file_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMyInventoryRequest) String() string {
+func (x *SpendMyItemsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMyInventoryRequest) ProtoMessage() {}
+func (*SpendMyItemsRequest) ProtoMessage() {}
 
-func (x *GetMyInventoryRequest) ProtoReflect() protoreflect.Message {
+func (x *SpendMyItemsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -291,19 +365,40 @@ func (x *GetMyInventoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMyInventoryRequest.ProtoReflect.Descriptor instead.
-func (*GetMyInventoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SpendMyItemsRequest.ProtoReflect.Descriptor instead.
+func (*SpendMyItemsRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetMyInventoryRequest) GetNamespace() string {
+func (x *SpendMyItemsRequest) GetNamespace() string {
 	if x != nil {
 		return x.Namespace
 	}
 	return ""
 }
 
-type GetEnergyRequest struct {
+func (x *SpendMyItemsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SpendMyItemsRequest) GetItems() []*ItemQuantity {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *SpendMyItemsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ClaimMyPendingItemsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -311,20 +406,20 @@ type GetEnergyRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEnergyRequest) Reset() {
-	*x = GetEnergyRequest{}
+func (x *ClaimMyPendingItemsRequest) Reset() {
+	*x = ClaimMyPendingItemsRequest{}
 	mi := &file_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEnergyRequest) String() string {
+func (x *ClaimMyPendingItemsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEnergyRequest) ProtoMessage() {}
+func (*ClaimMyPendingItemsRequest) ProtoMessage() {}
 
-func (x *GetEnergyRequest) ProtoReflect() protoreflect.Message {
+func (x *ClaimMyPendingItemsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -336,50 +431,52 @@ func (x *GetEnergyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEnergyRequest.ProtoReflect.Descriptor instead.
-func (*GetEnergyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ClaimMyPendingItemsRequest.ProtoReflect.Descriptor instead.
+func (*ClaimMyPendingItemsRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetEnergyRequest) GetNamespace() string {
+func (x *ClaimMyPendingItemsRequest) GetNamespace() string {
 	if x != nil {
 		return x.Namespace
 	}
 	return ""
 }
 
-func (x *GetEnergyRequest) GetUserId() string {
+func (x *ClaimMyPendingItemsRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-type ConsumeEnergyRequest struct {
+type RefillMyEnergyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Amount        int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`                          // Energy to consume
-	ActionType    string                 `protobuf:"bytes,4,opt,name=action_type,json=actionType,proto3" json:"action_type,omitempty"` // Type: plant, harvest, visit, craft, explore, special
-	ActionId      string                 `protobuf:"bytes,5,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`       // Optional specific action ID for analytics
+	Amount        int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`                                   // Energy to add
+	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`                                    // Source: purchase, reward, levelup, daily, ad_watch, gift
+	ItemId        string                 `protobuf:"bytes,5,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`                      // Item ID if source is 'purchase'
+	TransactionId string                 `protobuf:"bytes,6,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"` // Transaction ID, a retried refill with the same ID returns the original response
+	PoolId        string                 `protobuf:"bytes,7,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`                      // Optional energy pool to refill, default "energy". Purchases refill every pool of the item
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConsumeEnergyRequest) Reset() {
-	*x = ConsumeEnergyRequest{}
+func (x *RefillMyEnergyRequest) Reset() {
+	*x = RefillMyEnergyRequest{}
 	mi := &file_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConsumeEnergyRequest) String() string {
+func (x *RefillMyEnergyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConsumeEnergyRequest) ProtoMessage() {}
+func (*RefillMyEnergyRequest) ProtoMessage() {}
 
-func (x *ConsumeEnergyRequest) ProtoReflect() protoreflect.Message {
+func (x *RefillMyEnergyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -391,72 +488,82 @@ func (x *ConsumeEnergyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConsumeEnergyRequest.ProtoReflect.Descriptor instead.
-func (*ConsumeEnergyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefillMyEnergyRequest.ProtoReflect.Descriptor instead.
+func (*RefillMyEnergyRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ConsumeEnergyRequest) GetNamespace() string {
+func (x *RefillMyEnergyRequest) GetNamespace() string {
 	if x != nil {
 		return x.Namespace
 	}
 	return ""
 }
 
-func (x *ConsumeEnergyRequest) GetUserId() string {
+func (x *RefillMyEnergyRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *ConsumeEnergyRequest) GetAmount() int32 {
+func (x *RefillMyEnergyRequest) GetAmount() int32 {
 	if x != nil {
 		return x.Amount
 	}
 	return 0
 }
 
-func (x *ConsumeEnergyRequest) GetActionType() string {
+func (x *RefillMyEnergyRequest) GetSource() string {
 	if x != nil {
-		return x.ActionType
+		return x.Source
 	}
 	return ""
 }
 
-func (x *ConsumeEnergyRequest) GetActionId() string {
+func (x *RefillMyEnergyRequest) GetItemId() string {
 	if x != nil {
-		return x.ActionId
+		return x.ItemId
 	}
 	return ""
 }
 
-type RefillEnergyRequest struct {
+func (x *RefillMyEnergyRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *RefillMyEnergyRequest) GetPoolId() string {
+	if x != nil {
+		return x.PoolId
+	}
+	return ""
+}
+
+type GetMyEnergyConfigRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Amount        int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`                                   // Energy to add
-	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`                                    // Source: purchase, reward, levelup, daily, ad_watch, gift
-	ItemId        string                 `protobuf:"bytes,5,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`                      // Item ID if source is 'purchase'
-	TransactionId string                 `protobuf:"bytes,6,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"` // Transaction ID for validation
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RefillEnergyRequest) Reset() {
-	*x = RefillEnergyRequest{}
+func (x *GetMyEnergyConfigRequest) Reset() {
+	*x = GetMyEnergyConfigRequest{}
 	mi := &file_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RefillEnergyRequest) String() string {
+func (x *GetMyEnergyConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefillEnergyRequest) ProtoMessage() {}
+func (*GetMyEnergyConfigRequest) ProtoMessage() {}
 
-func (x *RefillEnergyRequest) ProtoReflect() protoreflect.Message {
+func (x *GetMyEnergyConfigRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -468,54 +575,86 @@ func (x *RefillEnergyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefillEnergyRequest.ProtoReflect.Descriptor instead.
-func (*RefillEnergyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMyEnergyConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetMyEnergyConfigRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *RefillEnergyRequest) GetNamespace() string {
+func (x *GetMyEnergyConfigRequest) GetNamespace() string {
 	if x != nil {
 		return x.Namespace
 	}
 	return ""
 }
 
-func (x *RefillEnergyRequest) GetUserId() string {
+func (x *GetMyEnergyConfigRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *RefillEnergyRequest) GetAmount() int32 {
+type GetMyInventoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Language      string                 `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"` // Language of the item names, e.g. ja or pt-BR (optional)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyInventoryRequest) Reset() {
+	*x = GetMyInventoryRequest{}
+	mi := &file_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyInventoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyInventoryRequest) ProtoMessage() {}
+
+func (x *GetMyInventoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[8]
 	if x != nil {
-		return x.Amount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *RefillEnergyRequest) GetSource() string {
+// Deprecated: Use GetMyInventoryRequest.ProtoReflect.Descriptor instead.
+func (*GetMyInventoryRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetMyInventoryRequest) GetNamespace() string {
 	if x != nil {
-		return x.Source
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *RefillEnergyRequest) GetItemId() string {
+func (x *GetMyInventoryRequest) GetUserId() string {
 	if x != nil {
-		return x.ItemId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *RefillEnergyRequest) GetTransactionId() string {
+func (x *GetMyInventoryRequest) GetLanguage() string {
 	if x != nil {
-		return x.TransactionId
+		return x.Language
 	}
 	return ""
 }
 
-type GetEnergyConfigRequest struct {
+type LevelUpMyEnergyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -523,21 +662,21 @@ type GetEnergyConfigRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEnergyConfigRequest) Reset() {
-	*x = GetEnergyConfigRequest{}
-	mi := &file_service_proto_msgTypes[8]
+func (x *LevelUpMyEnergyRequest) Reset() {
+	*x = LevelUpMyEnergyRequest{}
+	mi := &file_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEnergyConfigRequest) String() string {
+func (x *LevelUpMyEnergyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEnergyConfigRequest) ProtoMessage() {}
+func (*LevelUpMyEnergyRequest) ProtoMessage() {}
 
-func (x *GetEnergyConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[8]
+func (x *LevelUpMyEnergyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -548,50 +687,50 @@ func (x *GetEnergyConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEnergyConfigRequest.ProtoReflect.Descriptor instead.
-func (*GetEnergyConfigRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use LevelUpMyEnergyRequest.ProtoReflect.Descriptor instead.
+func (*LevelUpMyEnergyRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetEnergyConfigRequest) GetNamespace() string {
+func (x *LevelUpMyEnergyRequest) GetNamespace() string {
 	if x != nil {
 		return x.Namespace
 	}
 	return ""
 }
 
-func (x *GetEnergyConfigRequest) GetUserId() string {
+func (x *LevelUpMyEnergyRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-type UpdateEnergyConfigRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Namespace        string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	UserId           string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	MaxEnergy        int32                  `protobuf:"varint,3,opt,name=max_energy,json=maxEnergy,proto3" json:"max_energy,omitempty"`                        // New max energy (optional, 0 = no change)
-	RegenRateSeconds int32                  `protobuf:"varint,4,opt,name=regen_rate_seconds,json=regenRateSeconds,proto3" json:"regen_rate_seconds,omitempty"` // New regen rate in seconds (optional, 0 = no change)
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type ReserveEnergyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ActionType    string                 `protobuf:"bytes,3,opt,name=action_type,json=actionType,proto3" json:"action_type,omitempty"`  // Action to hold the cost of, e.g. fight
+	TtlSeconds    int64                  `protobuf:"varint,4,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"` // How long the hold lasts before it is refunded (optional, 0 = 300, max 3600)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateEnergyConfigRequest) Reset() {
-	*x = UpdateEnergyConfigRequest{}
-	mi := &file_service_proto_msgTypes[9]
+func (x *ReserveEnergyRequest) Reset() {
+	*x = ReserveEnergyRequest{}
+	mi := &file_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEnergyConfigRequest) String() string {
+func (x *ReserveEnergyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEnergyConfigRequest) ProtoMessage() {}
+func (*ReserveEnergyRequest) ProtoMessage() {}
 
-func (x *UpdateEnergyConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[9]
+func (x *ReserveEnergyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -602,62 +741,63 @@ func (x *UpdateEnergyConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEnergyConfigRequest.ProtoReflect.Descriptor instead.
-func (*UpdateEnergyConfigRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ReserveEnergyRequest.ProtoReflect.Descriptor instead.
+func (*ReserveEnergyRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *UpdateEnergyConfigRequest) GetNamespace() string {
+func (x *ReserveEnergyRequest) GetNamespace() string {
 	if x != nil {
 		return x.Namespace
 	}
 	return ""
 }
 
-func (x *UpdateEnergyConfigRequest) GetUserId() string {
+func (x *ReserveEnergyRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *UpdateEnergyConfigRequest) GetMaxEnergy() int32 {
+func (x *ReserveEnergyRequest) GetActionType() string {
 	if x != nil {
-		return x.MaxEnergy
+		return x.ActionType
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateEnergyConfigRequest) GetRegenRateSeconds() int32 {
+func (x *ReserveEnergyRequest) GetTtlSeconds() int64 {
 	if x != nil {
-		return x.RegenRateSeconds
+		return x.TtlSeconds
 	}
 	return 0
 }
 
-type ResetEnergyRequest struct {
+type CommitEnergyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HoldId        string                 `protobuf:"bytes,3,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResetEnergyRequest) Reset() {
-	*x = ResetEnergyRequest{}
-	mi := &file_service_proto_msgTypes[10]
+func (x *CommitEnergyRequest) Reset() {
+	*x = CommitEnergyRequest{}
+	mi := &file_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResetEnergyRequest) String() string {
+func (x *CommitEnergyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetEnergyRequest) ProtoMessage() {}
+func (*CommitEnergyRequest) ProtoMessage() {}
 
-func (x *ResetEnergyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[10]
+func (x *CommitEnergyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -668,93 +808,55 @@ func (x *ResetEnergyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetEnergyRequest.ProtoReflect.Descriptor instead.
-func (*ResetEnergyRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use CommitEnergyRequest.ProtoReflect.Descriptor instead.
+func (*CommitEnergyRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ResetEnergyRequest) GetNamespace() string {
+func (x *CommitEnergyRequest) GetNamespace() string {
 	if x != nil {
 		return x.Namespace
 	}
 	return ""
 }
 
-func (x *ResetEnergyRequest) GetUserId() string {
+func (x *CommitEnergyRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-type GetEnergyResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	EnergyState   *EnergyState           `protobuf:"bytes,1,opt,name=energy_state,json=energyState,proto3" json:"energy_state,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetEnergyResponse) Reset() {
-	*x = GetEnergyResponse{}
-	mi := &file_service_proto_msgTypes[11]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetEnergyResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetEnergyResponse) ProtoMessage() {}
-
-func (x *GetEnergyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[11]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetEnergyResponse.ProtoReflect.Descriptor instead.
-func (*GetEnergyResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *GetEnergyResponse) GetEnergyState() *EnergyState {
+func (x *CommitEnergyRequest) GetHoldId() string {
 	if x != nil {
-		return x.EnergyState
+		return x.HoldId
 	}
-	return nil
+	return ""
 }
 
-type ConsumeEnergyResponse struct {
+type ReleaseEnergyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EnergyState   *EnergyState           `protobuf:"bytes,1,opt,name=energy_state,json=energyState,proto3" json:"energy_state,omitempty"`
-	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Loot          []*LootItem            `protobuf:"bytes,4,rep,name=loot,proto3" json:"loot,omitempty"` // Loot earned from this action
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HoldId        string                 `protobuf:"bytes,3,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConsumeEnergyResponse) Reset() {
-	*x = ConsumeEnergyResponse{}
+func (x *ReleaseEnergyRequest) Reset() {
+	*x = ReleaseEnergyRequest{}
 	mi := &file_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConsumeEnergyResponse) String() string {
+func (x *ReleaseEnergyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConsumeEnergyResponse) ProtoMessage() {}
+func (*ReleaseEnergyRequest) ProtoMessage() {}
 
-func (x *ConsumeEnergyResponse) ProtoReflect() protoreflect.Message {
+func (x *ReleaseEnergyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -766,63 +868,58 @@ func (x *ConsumeEnergyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConsumeEnergyResponse.ProtoReflect.Descriptor instead.
-func (*ConsumeEnergyResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReleaseEnergyRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseEnergyRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ConsumeEnergyResponse) GetEnergyState() *EnergyState {
-	if x != nil {
-		return x.EnergyState
-	}
-	return nil
-}
-
-func (x *ConsumeEnergyResponse) GetSuccess() bool {
+func (x *ReleaseEnergyRequest) GetNamespace() string {
 	if x != nil {
-		return x.Success
+		return x.Namespace
 	}
-	return false
+	return ""
 }
 
-func (x *ConsumeEnergyResponse) GetMessage() string {
+func (x *ReleaseEnergyRequest) GetUserId() string {
 	if x != nil {
-		return x.Message
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *ConsumeEnergyResponse) GetLoot() []*LootItem {
+func (x *ReleaseEnergyRequest) GetHoldId() string {
 	if x != nil {
-		return x.Loot
+		return x.HoldId
 	}
-	return nil
+	return ""
 }
 
-// Loot item dropped from an action
-type LootItem struct {
+type ListMyEnergyHistoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	ItemName      string                 `protobuf:"bytes,2,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
-	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartTime     int64                  `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // Unix timestamp, only changes at or after it (optional)
+	EndTime       int64                  `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`       // Unix timestamp, only changes before it (optional)
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`    // Entries per page, default 20, at most 100
+	Cursor        string                 `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`                         // next_cursor of the previous page (optional)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LootItem) Reset() {
-	*x = LootItem{}
+func (x *ListMyEnergyHistoryRequest) Reset() {
+	*x = ListMyEnergyHistoryRequest{}
 	mi := &file_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LootItem) String() string {
+func (x *ListMyEnergyHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LootItem) ProtoMessage() {}
+func (*ListMyEnergyHistoryRequest) ProtoMessage() {}
 
-func (x *LootItem) ProtoReflect() protoreflect.Message {
+func (x *ListMyEnergyHistoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -834,55 +931,76 @@ func (x *LootItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LootItem.ProtoReflect.Descriptor instead.
-func (*LootItem) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListMyEnergyHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListMyEnergyHistoryRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *LootItem) GetItemId() string {
+func (x *ListMyEnergyHistoryRequest) GetNamespace() string {
 	if x != nil {
-		return x.ItemId
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *LootItem) GetItemName() string {
+func (x *ListMyEnergyHistoryRequest) GetUserId() string {
 	if x != nil {
-		return x.ItemName
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *LootItem) GetQuantity() int32 {
+func (x *ListMyEnergyHistoryRequest) GetStartTime() int64 {
 	if x != nil {
-		return x.Quantity
+		return x.StartTime
 	}
 	return 0
 }
 
-type RefillEnergyResponse struct {
+func (x *ListMyEnergyHistoryRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *ListMyEnergyHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMyEnergyHistoryRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ListItemCatalogRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EnergyState   *EnergyState           `protobuf:"bytes,1,opt,name=energy_state,json=energyState,proto3" json:"energy_state,omitempty"`
-	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Language      string                 `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"` // Language of the item names, e.g. ja or pt-BR (optional)
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"` // Only items of this category (optional)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RefillEnergyResponse) Reset() {
-	*x = RefillEnergyResponse{}
+func (x *ListItemCatalogRequest) Reset() {
+	*x = ListItemCatalogRequest{}
 	mi := &file_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RefillEnergyResponse) String() string {
+func (x *ListItemCatalogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefillEnergyResponse) ProtoMessage() {}
+func (*ListItemCatalogRequest) ProtoMessage() {}
 
-func (x *RefillEnergyResponse) ProtoReflect() protoreflect.Message {
+func (x *ListItemCatalogRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -894,53 +1012,55 @@ func (x *RefillEnergyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefillEnergyResponse.ProtoReflect.Descriptor instead.
-func (*RefillEnergyResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListItemCatalogRequest.ProtoReflect.Descriptor instead.
+func (*ListItemCatalogRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *RefillEnergyResponse) GetEnergyState() *EnergyState {
+func (x *ListItemCatalogRequest) GetNamespace() string {
 	if x != nil {
-		return x.EnergyState
+		return x.Namespace
 	}
-	return nil
+	return ""
 }
 
-func (x *RefillEnergyResponse) GetSuccess() bool {
+func (x *ListItemCatalogRequest) GetLanguage() string {
 	if x != nil {
-		return x.Success
+		return x.Language
 	}
-	return false
+	return ""
 }
 
-func (x *RefillEnergyResponse) GetMessage() string {
+func (x *ListItemCatalogRequest) GetCategory() string {
 	if x != nil {
-		return x.Message
+		return x.Category
 	}
 	return ""
 }
 
-type GetEnergyConfigResponse struct {
+type CraftItemRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Config        *EnergyConfig          `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RecipeId      string                 `protobuf:"bytes,3,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEnergyConfigResponse) Reset() {
-	*x = GetEnergyConfigResponse{}
+func (x *CraftItemRequest) Reset() {
+	*x = CraftItemRequest{}
 	mi := &file_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEnergyConfigResponse) String() string {
+func (x *CraftItemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEnergyConfigResponse) ProtoMessage() {}
+func (*CraftItemRequest) ProtoMessage() {}
 
-func (x *GetEnergyConfigResponse) ProtoReflect() protoreflect.Message {
+func (x *CraftItemRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -952,39 +1072,55 @@ func (x *GetEnergyConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEnergyConfigResponse.ProtoReflect.Descriptor instead.
-func (*GetEnergyConfigResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CraftItemRequest.ProtoReflect.Descriptor instead.
+func (*CraftItemRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetEnergyConfigResponse) GetConfig() *EnergyConfig {
+func (x *CraftItemRequest) GetNamespace() string {
 	if x != nil {
-		return x.Config
+		return x.Namespace
 	}
-	return nil
+	return ""
 }
 
-type GetInventoryResponse struct {
+func (x *CraftItemRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CraftItemRequest) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+type ListRecipesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*InventoryItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Language      string                 `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"` // Language of the item names, e.g. ja or pt-BR (optional)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetInventoryResponse) Reset() {
-	*x = GetInventoryResponse{}
+func (x *ListRecipesRequest) Reset() {
+	*x = ListRecipesRequest{}
 	mi := &file_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetInventoryResponse) String() string {
+func (x *ListRecipesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInventoryResponse) ProtoMessage() {}
+func (*ListRecipesRequest) ProtoMessage() {}
 
-func (x *GetInventoryResponse) ProtoReflect() protoreflect.Message {
+func (x *ListRecipesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -996,42 +1132,55 @@ func (x *GetInventoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInventoryResponse.ProtoReflect.Descriptor instead.
-func (*GetInventoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRecipesRequest.ProtoReflect.Descriptor instead.
+func (*ListRecipesRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetInventoryResponse) GetItems() []*InventoryItem {
+func (x *ListRecipesRequest) GetNamespace() string {
 	if x != nil {
-		return x.Items
+		return x.Namespace
 	}
-	return nil
+	return ""
 }
 
-// Item in player's inventory
-type InventoryItem struct {
+func (x *ListRecipesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListRecipesRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type ExchangeForEnergyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	ItemName      string                 `protobuf:"bytes,2,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
-	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OfferId       string                 `protobuf:"bytes,3,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"` // Exchange offer, e.g. gem_energy
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InventoryItem) Reset() {
-	*x = InventoryItem{}
+func (x *ExchangeForEnergyRequest) Reset() {
+	*x = ExchangeForEnergyRequest{}
 	mi := &file_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InventoryItem) String() string {
+func (x *ExchangeForEnergyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InventoryItem) ProtoMessage() {}
+func (*ExchangeForEnergyRequest) ProtoMessage() {}
 
-func (x *InventoryItem) ProtoReflect() protoreflect.Message {
+func (x *ExchangeForEnergyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1043,55 +1192,55 @@ func (x *InventoryItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InventoryItem.ProtoReflect.Descriptor instead.
-func (*InventoryItem) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExchangeForEnergyRequest.ProtoReflect.Descriptor instead.
+func (*ExchangeForEnergyRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *InventoryItem) GetItemId() string {
+func (x *ExchangeForEnergyRequest) GetNamespace() string {
 	if x != nil {
-		return x.ItemId
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *InventoryItem) GetItemName() string {
+func (x *ExchangeForEnergyRequest) GetUserId() string {
 	if x != nil {
-		return x.ItemName
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *InventoryItem) GetQuantity() int32 {
+func (x *ExchangeForEnergyRequest) GetOfferId() string {
 	if x != nil {
-		return x.Quantity
+		return x.OfferId
 	}
-	return 0
+	return ""
 }
 
-type UpdateEnergyConfigResponse struct {
+type GetEnergyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Config        *EnergyConfig          `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
-	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PoolId        string                 `protobuf:"bytes,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"` // Optional energy pool, default "energy"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateEnergyConfigResponse) Reset() {
-	*x = UpdateEnergyConfigResponse{}
+func (x *GetEnergyRequest) Reset() {
+	*x = GetEnergyRequest{}
 	mi := &file_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEnergyConfigResponse) String() string {
+func (x *GetEnergyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEnergyConfigResponse) ProtoMessage() {}
+func (*GetEnergyRequest) ProtoMessage() {}
 
-func (x *UpdateEnergyConfigResponse) ProtoReflect() protoreflect.Message {
+func (x *GetEnergyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1103,56 +1252,3586 @@ func (x *UpdateEnergyConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEnergyConfigResponse.ProtoReflect.Descriptor instead.
-func (*UpdateEnergyConfigResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetEnergyRequest.ProtoReflect.Descriptor instead.
+func (*GetEnergyRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *UpdateEnergyConfigResponse) GetConfig() *EnergyConfig {
+func (x *GetEnergyRequest) GetNamespace() string {
 	if x != nil {
-		return x.Config
+		return x.Namespace
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateEnergyConfigResponse) GetSuccess() bool {
+func (x *GetEnergyRequest) GetUserId() string {
 	if x != nil {
-		return x.Success
+		return x.UserId
 	}
-	return false
+	return ""
 }
 
-func (x *UpdateEnergyConfigResponse) GetMessage() string {
+func (x *GetEnergyRequest) GetPoolId() string {
 	if x != nil {
-		return x.Message
+		return x.PoolId
 	}
 	return ""
 }
 
-type ResetEnergyResponse struct {
+type ConsumeEnergyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EnergyState   *EnergyState           `protobuf:"bytes,1,opt,name=energy_state,json=energyState,proto3" json:"energy_state,omitempty"`
-	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount        int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`                          // Energy to consume
+	ActionType    string                 `protobuf:"bytes,4,opt,name=action_type,json=actionType,proto3" json:"action_type,omitempty"` // Type: plant, harvest, visit, craft, explore, special
+	ActionId      string                 `protobuf:"bytes,5,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`       // Optional specific action ID for analytics
+	PoolId        string                 `protobuf:"bytes,6,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`             // Optional energy pool to consume from, default "energy"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsumeEnergyRequest) Reset() {
+	*x = ConsumeEnergyRequest{}
+	mi := &file_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumeEnergyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumeEnergyRequest) ProtoMessage() {}
+
+func (x *ConsumeEnergyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumeEnergyRequest.ProtoReflect.Descriptor instead.
+func (*ConsumeEnergyRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ConsumeEnergyRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ConsumeEnergyRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ConsumeEnergyRequest) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ConsumeEnergyRequest) GetActionType() string {
+	if x != nil {
+		return x.ActionType
+	}
+	return ""
+}
+
+func (x *ConsumeEnergyRequest) GetActionId() string {
+	if x != nil {
+		return x.ActionId
+	}
+	return ""
+}
+
+func (x *ConsumeEnergyRequest) GetPoolId() string {
+	if x != nil {
+		return x.PoolId
+	}
+	return ""
+}
+
+type RefillEnergyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount        int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`                                   // Energy to add
+	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`                                    // Source: purchase, reward, levelup, daily, ad_watch, gift
+	ItemId        string                 `protobuf:"bytes,5,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`                      // Item ID if source is 'purchase'
+	TransactionId string                 `protobuf:"bytes,6,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"` // Transaction ID, a retried refill with the same ID returns the original response
+	PoolId        string                 `protobuf:"bytes,7,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`                      // Optional energy pool to refill, default "energy"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefillEnergyRequest) Reset() {
+	*x = RefillEnergyRequest{}
+	mi := &file_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefillEnergyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefillEnergyRequest) ProtoMessage() {}
+
+func (x *RefillEnergyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefillEnergyRequest.ProtoReflect.Descriptor instead.
+func (*RefillEnergyRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RefillEnergyRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *RefillEnergyRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RefillEnergyRequest) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RefillEnergyRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *RefillEnergyRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *RefillEnergyRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *RefillEnergyRequest) GetPoolId() string {
+	if x != nil {
+		return x.PoolId
+	}
+	return ""
+}
+
+type GetEnergyConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResetEnergyResponse) Reset() {
-	*x = ResetEnergyResponse{}
-	mi := &file_service_proto_msgTypes[19]
+func (x *GetEnergyConfigRequest) Reset() {
+	*x = GetEnergyConfigRequest{}
+	mi := &file_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEnergyConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnergyConfigRequest) ProtoMessage() {}
+
+func (x *GetEnergyConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnergyConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetEnergyConfigRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetEnergyConfigRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetEnergyConfigRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UpdateEnergyConfigRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Namespace        string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	UserId           string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MaxEnergy        int32                  `protobuf:"varint,3,opt,name=max_energy,json=maxEnergy,proto3" json:"max_energy,omitempty"`                        // New max energy (optional, 0 = no change)
+	RegenRateSeconds int32                  `protobuf:"varint,4,opt,name=regen_rate_seconds,json=regenRateSeconds,proto3" json:"regen_rate_seconds,omitempty"` // New regen rate in seconds (optional, 0 = no change)
+	ClearOverrides   bool                   `protobuf:"varint,5,opt,name=clear_overrides,json=clearOverrides,proto3" json:"clear_overrides,omitempty"`         // Remove existing overrides first, so the energy level applies again
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateEnergyConfigRequest) Reset() {
+	*x = UpdateEnergyConfigRequest{}
+	mi := &file_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEnergyConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEnergyConfigRequest) ProtoMessage() {}
+
+func (x *UpdateEnergyConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEnergyConfigRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEnergyConfigRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateEnergyConfigRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *UpdateEnergyConfigRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateEnergyConfigRequest) GetMaxEnergy() int32 {
+	if x != nil {
+		return x.MaxEnergy
+	}
+	return 0
+}
+
+func (x *UpdateEnergyConfigRequest) GetRegenRateSeconds() int32 {
+	if x != nil {
+		return x.RegenRateSeconds
+	}
+	return 0
+}
+
+func (x *UpdateEnergyConfigRequest) GetClearOverrides() bool {
+	if x != nil {
+		return x.ClearOverrides
+	}
+	return false
+}
+
+type SetEnergyLevelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Level         int32                  `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"` // New energy level, from 1 to the highest configured level
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetEnergyLevelRequest) Reset() {
+	*x = SetEnergyLevelRequest{}
+	mi := &file_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEnergyLevelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEnergyLevelRequest) ProtoMessage() {}
+
+func (x *SetEnergyLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEnergyLevelRequest.ProtoReflect.Descriptor instead.
+func (*SetEnergyLevelRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetEnergyLevelRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *SetEnergyLevelRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetEnergyLevelRequest) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+type ResetEnergyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WipeInventory bool                   `protobuf:"varint,3,opt,name=wipe_inventory,json=wipeInventory,proto3" json:"wipe_inventory,omitempty"` // Also clear the inventory (default false = inventory is kept)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetEnergyRequest) Reset() {
+	*x = ResetEnergyRequest{}
+	mi := &file_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetEnergyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetEnergyRequest) ProtoMessage() {}
+
+func (x *ResetEnergyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetEnergyRequest.ProtoReflect.Descriptor instead.
+func (*ResetEnergyRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ResetEnergyRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ResetEnergyRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ResetEnergyRequest) GetWipeInventory() bool {
+	if x != nil {
+		return x.WipeInventory
+	}
+	return false
+}
+
+type GrantItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Items         []*ItemQuantity        `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"` // Why the items were granted, recorded in the history (optional)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantItemsRequest) Reset() {
+	*x = GrantItemsRequest{}
+	mi := &file_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantItemsRequest) ProtoMessage() {}
+
+func (x *GrantItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantItemsRequest.ProtoReflect.Descriptor instead.
+func (*GrantItemsRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GrantItemsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GrantItemsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GrantItemsRequest) GetItems() []*ItemQuantity {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GrantItemsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RevokeItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Items         []*ItemQuantity        `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"` // Why the items were revoked, recorded in the history (optional)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeItemsRequest) Reset() {
+	*x = RevokeItemsRequest{}
+	mi := &file_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeItemsRequest) ProtoMessage() {}
+
+func (x *RevokeItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeItemsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeItemsRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RevokeItemsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *RevokeItemsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RevokeItemsRequest) GetItems() []*ItemQuantity {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *RevokeItemsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListEnergyTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartTime     int64                  `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // Unix timestamp, only changes at or after it (optional)
+	EndTime       int64                  `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`       // Unix timestamp, only changes before it (optional)
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`    // Entries per page, default 20, at most 100
+	Cursor        string                 `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`                         // next_cursor of the previous page (optional)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEnergyTransactionsRequest) Reset() {
+	*x = ListEnergyTransactionsRequest{}
+	mi := &file_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEnergyTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEnergyTransactionsRequest) ProtoMessage() {}
+
+func (x *ListEnergyTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEnergyTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListEnergyTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListEnergyTransactionsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListEnergyTransactionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListEnergyTransactionsRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *ListEnergyTransactionsRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *ListEnergyTransactionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEnergyTransactionsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetNamespaceEnergyConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNamespaceEnergyConfigRequest) Reset() {
+	*x = GetNamespaceEnergyConfigRequest{}
+	mi := &file_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNamespaceEnergyConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespaceEnergyConfigRequest) ProtoMessage() {}
+
+func (x *GetNamespaceEnergyConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespaceEnergyConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetNamespaceEnergyConfigRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetNamespaceEnergyConfigRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type CreateRegenBoostRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Namespace         string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	UserId            string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                   // Player to boost, empty for a namespace-wide event
+	MultiplierPercent int32                  `protobuf:"varint,3,opt,name=multiplier_percent,json=multiplierPercent,proto3" json:"multiplier_percent,omitempty"` // Regen speed during the boost, e.g. 200 = twice as fast
+	PoolId            string                 `protobuf:"bytes,4,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`                                   // Boosted energy pool, empty for every pool
+	StartTime         int64                  `protobuf:"varint,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`                         // Unix timestamp, 0 = now
+	DurationSeconds   int64                  `protobuf:"varint,6,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`       // How long the boost lasts
+	Reason            string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`                                                 // Optional, e.g. "happy hour" or the consumable item ID
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateRegenBoostRequest) Reset() {
+	*x = CreateRegenBoostRequest{}
+	mi := &file_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRegenBoostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRegenBoostRequest) ProtoMessage() {}
+
+func (x *CreateRegenBoostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRegenBoostRequest.ProtoReflect.Descriptor instead.
+func (*CreateRegenBoostRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateRegenBoostRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CreateRegenBoostRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateRegenBoostRequest) GetMultiplierPercent() int32 {
+	if x != nil {
+		return x.MultiplierPercent
+	}
+	return 0
+}
+
+func (x *CreateRegenBoostRequest) GetPoolId() string {
+	if x != nil {
+		return x.PoolId
+	}
+	return ""
+}
+
+func (x *CreateRegenBoostRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *CreateRegenBoostRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *CreateRegenBoostRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListRegenBoostsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Player whose boosts to list, empty for the namespace-wide events
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRegenBoostsRequest) Reset() {
+	*x = ListRegenBoostsRequest{}
+	mi := &file_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRegenBoostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRegenBoostsRequest) ProtoMessage() {}
+
+func (x *ListRegenBoostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRegenBoostsRequest.ProtoReflect.Descriptor instead.
+func (*ListRegenBoostsRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListRegenBoostsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListRegenBoostsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type CancelRegenBoostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Player the boost belongs to, empty for a namespace-wide event
+	BoostId       string                 `protobuf:"bytes,3,opt,name=boost_id,json=boostId,proto3" json:"boost_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelRegenBoostRequest) Reset() {
+	*x = CancelRegenBoostRequest{}
+	mi := &file_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelRegenBoostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRegenBoostRequest) ProtoMessage() {}
+
+func (x *CancelRegenBoostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRegenBoostRequest.ProtoReflect.Descriptor instead.
+func (*CancelRegenBoostRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CancelRegenBoostRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CancelRegenBoostRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CancelRegenBoostRequest) GetBoostId() string {
+	if x != nil {
+		return x.BoostId
+	}
+	return ""
+}
+
+type GetDebugTimeOffsetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDebugTimeOffsetRequest) Reset() {
+	*x = GetDebugTimeOffsetRequest{}
+	mi := &file_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDebugTimeOffsetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDebugTimeOffsetRequest) ProtoMessage() {}
+
+func (x *GetDebugTimeOffsetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDebugTimeOffsetRequest.ProtoReflect.Descriptor instead.
+func (*GetDebugTimeOffsetRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetDebugTimeOffsetRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type SetDebugTimeOffsetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	OffsetSeconds int64                  `protobuf:"varint,2,opt,name=offset_seconds,json=offsetSeconds,proto3" json:"offset_seconds,omitempty"` // How far ahead of real time the namespace runs, 0 = real time
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDebugTimeOffsetRequest) Reset() {
+	*x = SetDebugTimeOffsetRequest{}
+	mi := &file_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDebugTimeOffsetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDebugTimeOffsetRequest) ProtoMessage() {}
+
+func (x *SetDebugTimeOffsetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDebugTimeOffsetRequest.ProtoReflect.Descriptor instead.
+func (*SetDebugTimeOffsetRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SetDebugTimeOffsetRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *SetDebugTimeOffsetRequest) GetOffsetSeconds() int64 {
+	if x != nil {
+		return x.OffsetSeconds
+	}
+	return 0
+}
+
+type UpdateNamespaceEnergyConfigRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Namespace        string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	MaxEnergy        int32                  `protobuf:"varint,2,opt,name=max_energy,json=maxEnergy,proto3" json:"max_energy,omitempty"`                        // New max energy (optional, 0 = no change)
+	RegenRateSeconds int32                  `protobuf:"varint,3,opt,name=regen_rate_seconds,json=regenRateSeconds,proto3" json:"regen_rate_seconds,omitempty"` // New regen rate in seconds (optional, 0 = no change)
+	StartingEnergy   int32                  `protobuf:"varint,4,opt,name=starting_energy,json=startingEnergy,proto3" json:"starting_energy,omitempty"`         // New starting energy (optional, 0 = no change)
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateNamespaceEnergyConfigRequest) Reset() {
+	*x = UpdateNamespaceEnergyConfigRequest{}
+	mi := &file_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNamespaceEnergyConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNamespaceEnergyConfigRequest) ProtoMessage() {}
+
+func (x *UpdateNamespaceEnergyConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNamespaceEnergyConfigRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNamespaceEnergyConfigRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UpdateNamespaceEnergyConfigRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *UpdateNamespaceEnergyConfigRequest) GetMaxEnergy() int32 {
+	if x != nil {
+		return x.MaxEnergy
+	}
+	return 0
+}
+
+func (x *UpdateNamespaceEnergyConfigRequest) GetRegenRateSeconds() int32 {
+	if x != nil {
+		return x.RegenRateSeconds
+	}
+	return 0
+}
+
+func (x *UpdateNamespaceEnergyConfigRequest) GetStartingEnergy() int32 {
+	if x != nil {
+		return x.StartingEnergy
+	}
+	return 0
+}
+
+type GetEnergyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EnergyState   *EnergyState           `protobuf:"bytes,1,opt,name=energy_state,json=energyState,proto3" json:"energy_state,omitempty"` // The requested pool
+	Pools         []*EnergyState         `protobuf:"bytes,2,rep,name=pools,proto3" json:"pools,omitempty"`                                // Every energy pool of the player
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEnergyResponse) Reset() {
+	*x = GetEnergyResponse{}
+	mi := &file_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEnergyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnergyResponse) ProtoMessage() {}
+
+func (x *GetEnergyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnergyResponse.ProtoReflect.Descriptor instead.
+func (*GetEnergyResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetEnergyResponse) GetEnergyState() *EnergyState {
+	if x != nil {
+		return x.EnergyState
+	}
+	return nil
+}
+
+func (x *GetEnergyResponse) GetPools() []*EnergyState {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+type ConsumeEnergyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EnergyState   *EnergyState           `protobuf:"bytes,1,opt,name=energy_state,json=energyState,proto3" json:"energy_state,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Loot          []*LootItem            `protobuf:"bytes,4,rep,name=loot,proto3" json:"loot,omitempty"`   // Loot earned from this action
+	Pools         []*EnergyState         `protobuf:"bytes,5,rep,name=pools,proto3" json:"pools,omitempty"` // Every energy pool the action consumes from
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsumeEnergyResponse) Reset() {
+	*x = ConsumeEnergyResponse{}
+	mi := &file_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumeEnergyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumeEnergyResponse) ProtoMessage() {}
+
+func (x *ConsumeEnergyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumeEnergyResponse.ProtoReflect.Descriptor instead.
+func (*ConsumeEnergyResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ConsumeEnergyResponse) GetEnergyState() *EnergyState {
+	if x != nil {
+		return x.EnergyState
+	}
+	return nil
+}
+
+func (x *ConsumeEnergyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ConsumeEnergyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ConsumeEnergyResponse) GetLoot() []*LootItem {
+	if x != nil {
+		return x.Loot
+	}
+	return nil
+}
+
+func (x *ConsumeEnergyResponse) GetPools() []*EnergyState {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+type ReserveEnergyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hold          *EnergyHold            `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	EnergyState   *EnergyState           `protobuf:"bytes,2,opt,name=energy_state,json=energyState,proto3" json:"energy_state,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Pools         []*EnergyState         `protobuf:"bytes,5,rep,name=pools,proto3" json:"pools,omitempty"` // Every energy pool the hold deducts from
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveEnergyResponse) Reset() {
+	*x = ReserveEnergyResponse{}
+	mi := &file_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveEnergyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveEnergyResponse) ProtoMessage() {}
+
+func (x *ReserveEnergyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveEnergyResponse.ProtoReflect.Descriptor instead.
+func (*ReserveEnergyResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ReserveEnergyResponse) GetHold() *EnergyHold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+func (x *ReserveEnergyResponse) GetEnergyState() *EnergyState {
+	if x != nil {
+		return x.EnergyState
+	}
+	return nil
+}
+
+func (x *ReserveEnergyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReserveEnergyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReserveEnergyResponse) GetPools() []*EnergyState {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+type CommitEnergyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hold          *EnergyHold            `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Loot          []*LootItem            `protobuf:"bytes,4,rep,name=loot,proto3" json:"loot,omitempty"` // Loot earned from the action
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitEnergyResponse) Reset() {
+	*x = CommitEnergyResponse{}
+	mi := &file_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitEnergyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitEnergyResponse) ProtoMessage() {}
+
+func (x *CommitEnergyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitEnergyResponse.ProtoReflect.Descriptor instead.
+func (*CommitEnergyResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CommitEnergyResponse) GetHold() *EnergyHold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+func (x *CommitEnergyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CommitEnergyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CommitEnergyResponse) GetLoot() []*LootItem {
+	if x != nil {
+		return x.Loot
+	}
+	return nil
+}
+
+type ReleaseEnergyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hold          *EnergyHold            `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Pools         []*EnergyState         `protobuf:"bytes,4,rep,name=pools,proto3" json:"pools,omitempty"` // Every energy pool the hold was refunded to
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseEnergyResponse) Reset() {
+	*x = ReleaseEnergyResponse{}
+	mi := &file_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseEnergyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseEnergyResponse) ProtoMessage() {}
+
+func (x *ReleaseEnergyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseEnergyResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseEnergyResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ReleaseEnergyResponse) GetHold() *EnergyHold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+func (x *ReleaseEnergyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReleaseEnergyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReleaseEnergyResponse) GetPools() []*EnergyState {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+type ConsumeEnergyBatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EnergyState   *EnergyState           `protobuf:"bytes,1,opt,name=energy_state,json=energyState,proto3" json:"energy_state,omitempty"` // Default pool, or the pool short of energy when nothing was consumed
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`                           // Whether any action was performed
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Loot          []*LootItem            `protobuf:"bytes,4,rep,name=loot,proto3" json:"loot,omitempty"`       // Loot earned from every action, merged by item
+	Pools         []*EnergyState         `protobuf:"bytes,5,rep,name=pools,proto3" json:"pools,omitempty"`     // Every energy pool the batch consumes from
+	Results       []*BatchActionResult   `protobuf:"bytes,6,rep,name=results,proto3" json:"results,omitempty"` // Per action, in request order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsumeEnergyBatchResponse) Reset() {
+	*x = ConsumeEnergyBatchResponse{}
+	mi := &file_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumeEnergyBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumeEnergyBatchResponse) ProtoMessage() {}
+
+func (x *ConsumeEnergyBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumeEnergyBatchResponse.ProtoReflect.Descriptor instead.
+func (*ConsumeEnergyBatchResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ConsumeEnergyBatchResponse) GetEnergyState() *EnergyState {
+	if x != nil {
+		return x.EnergyState
+	}
+	return nil
+}
+
+func (x *ConsumeEnergyBatchResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ConsumeEnergyBatchResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ConsumeEnergyBatchResponse) GetLoot() []*LootItem {
+	if x != nil {
+		return x.Loot
+	}
+	return nil
+}
+
+func (x *ConsumeEnergyBatchResponse) GetPools() []*EnergyState {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+func (x *ConsumeEnergyBatchResponse) GetResults() []*BatchActionResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchActionResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActionType    string                 `protobuf:"bytes,1,opt,name=action_type,json=actionType,proto3" json:"action_type,omitempty"`
+	Requested     int32                  `protobuf:"varint,2,opt,name=requested,proto3" json:"requested,omitempty"`                                                                   // Times the action was requested
+	Performed     int32                  `protobuf:"varint,3,opt,name=performed,proto3" json:"performed,omitempty"`                                                                   // Times the action was performed
+	Costs         map[string]int32       `protobuf:"bytes,4,rep,name=costs,proto3" json:"costs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // pool_id -> energy consumed by this action
+	Loot          []*LootItem            `protobuf:"bytes,5,rep,name=loot,proto3" json:"loot,omitempty"`                                                                              // Loot earned from this action, merged by item
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchActionResult) Reset() {
+	*x = BatchActionResult{}
+	mi := &file_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchActionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchActionResult) ProtoMessage() {}
+
+func (x *BatchActionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchActionResult.ProtoReflect.Descriptor instead.
+func (*BatchActionResult) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *BatchActionResult) GetActionType() string {
+	if x != nil {
+		return x.ActionType
+	}
+	return ""
+}
+
+func (x *BatchActionResult) GetRequested() int32 {
+	if x != nil {
+		return x.Requested
+	}
+	return 0
+}
+
+func (x *BatchActionResult) GetPerformed() int32 {
+	if x != nil {
+		return x.Performed
+	}
+	return 0
+}
+
+func (x *BatchActionResult) GetCosts() map[string]int32 {
+	if x != nil {
+		return x.Costs
+	}
+	return nil
+}
+
+func (x *BatchActionResult) GetLoot() []*LootItem {
+	if x != nil {
+		return x.Loot
+	}
+	return nil
+}
+
+// Loot item dropped from an action
+type LootItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ItemName      string                 `protobuf:"bytes,2,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Granted       int32                  `protobuf:"varint,4,opt,name=granted,proto3" json:"granted,omitempty"`  // Quantity added to the inventory, for loot granted to the player
+	Overflow      string                 `protobuf:"bytes,5,opt,name=overflow,proto3" json:"overflow,omitempty"` // What happened to the quantity that did not fit: discarded, converted or pending
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LootItem) Reset() {
+	*x = LootItem{}
+	mi := &file_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LootItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LootItem) ProtoMessage() {}
+
+func (x *LootItem) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LootItem.ProtoReflect.Descriptor instead.
+func (*LootItem) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *LootItem) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *LootItem) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *LootItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *LootItem) GetGranted() int32 {
+	if x != nil {
+		return x.Granted
+	}
+	return 0
+}
+
+func (x *LootItem) GetOverflow() string {
+	if x != nil {
+		return x.Overflow
+	}
+	return ""
+}
+
+type RefillEnergyResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	EnergyState       *EnergyState           `protobuf:"bytes,1,opt,name=energy_state,json=energyState,proto3" json:"energy_state,omitempty"`
+	Success           bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message           string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	NextAvailableTime int64                  `protobuf:"varint,4,opt,name=next_available_time,json=nextAvailableTime,proto3" json:"next_available_time,omitempty"` // Unix timestamp the player can next refill from the source, 0 if available now
+	UsesToday         int32                  `protobuf:"varint,5,opt,name=uses_today,json=usesToday,proto3" json:"uses_today,omitempty"`                           // Refills from the source today, counted for sources with a daily limit
+	MaxUsesPerDay     int32                  `protobuf:"varint,6,opt,name=max_uses_per_day,json=maxUsesPerDay,proto3" json:"max_uses_per_day,omitempty"`           // Daily limit of the source, 0 = unlimited
+	Pools             []*EnergyState         `protobuf:"bytes,7,rep,name=pools,proto3" json:"pools,omitempty"`                                                     // Every energy pool the refill credits
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RefillEnergyResponse) Reset() {
+	*x = RefillEnergyResponse{}
+	mi := &file_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefillEnergyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefillEnergyResponse) ProtoMessage() {}
+
+func (x *RefillEnergyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefillEnergyResponse.ProtoReflect.Descriptor instead.
+func (*RefillEnergyResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RefillEnergyResponse) GetEnergyState() *EnergyState {
+	if x != nil {
+		return x.EnergyState
+	}
+	return nil
+}
+
+func (x *RefillEnergyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RefillEnergyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RefillEnergyResponse) GetNextAvailableTime() int64 {
+	if x != nil {
+		return x.NextAvailableTime
+	}
+	return 0
+}
+
+func (x *RefillEnergyResponse) GetUsesToday() int32 {
+	if x != nil {
+		return x.UsesToday
+	}
+	return 0
+}
+
+func (x *RefillEnergyResponse) GetMaxUsesPerDay() int32 {
+	if x != nil {
+		return x.MaxUsesPerDay
+	}
+	return 0
+}
+
+func (x *RefillEnergyResponse) GetPools() []*EnergyState {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+type GetEnergyConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *EnergyConfig          `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEnergyConfigResponse) Reset() {
+	*x = GetEnergyConfigResponse{}
+	mi := &file_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEnergyConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnergyConfigResponse) ProtoMessage() {}
+
+func (x *GetEnergyConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnergyConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetEnergyConfigResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetEnergyConfigResponse) GetConfig() *EnergyConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type GetInventoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*InventoryItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	PendingItems  []*InventoryItem       `protobuf:"bytes,2,rep,name=pending_items,json=pendingItems,proto3" json:"pending_items,omitempty"` // Loot that did not fit, waiting to be claimed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInventoryResponse) Reset() {
+	*x = GetInventoryResponse{}
+	mi := &file_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInventoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInventoryResponse) ProtoMessage() {}
+
+func (x *GetInventoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInventoryResponse.ProtoReflect.Descriptor instead.
+func (*GetInventoryResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetInventoryResponse) GetItems() []*InventoryItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetInventoryResponse) GetPendingItems() []*InventoryItem {
+	if x != nil {
+		return x.PendingItems
+	}
+	return nil
+}
+
+// Item in player's inventory
+type InventoryItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ItemName      string                 `protobuf:"bytes,2,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Rarity        string                 `protobuf:"bytes,4,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Category      string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	IconKey       string                 `protobuf:"bytes,6,opt,name=icon_key,json=iconKey,proto3" json:"icon_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryItem) Reset() {
+	*x = InventoryItem{}
+	mi := &file_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryItem) ProtoMessage() {}
+
+func (x *InventoryItem) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryItem.ProtoReflect.Descriptor instead.
+func (*InventoryItem) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *InventoryItem) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *InventoryItem) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *InventoryItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *InventoryItem) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *InventoryItem) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *InventoryItem) GetIconKey() string {
+	if x != nil {
+		return x.IconKey
+	}
+	return ""
+}
+
+// Quantity of an item to spend, grant or revoke
+type ItemQuantity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemQuantity) Reset() {
+	*x = ItemQuantity{}
+	mi := &file_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemQuantity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemQuantity) ProtoMessage() {}
+
+func (x *ItemQuantity) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemQuantity.ProtoReflect.Descriptor instead.
+func (*ItemQuantity) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ItemQuantity) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *ItemQuantity) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// Item the player holds too few of
+type ItemShortfall struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Required      int32                  `protobuf:"varint,2,opt,name=required,proto3" json:"required,omitempty"`
+	Available     int32                  `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemShortfall) Reset() {
+	*x = ItemShortfall{}
+	mi := &file_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemShortfall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemShortfall) ProtoMessage() {}
+
+func (x *ItemShortfall) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemShortfall.ProtoReflect.Descriptor instead.
+func (*ItemShortfall) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ItemShortfall) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *ItemShortfall) GetRequired() int32 {
+	if x != nil {
+		return x.Required
+	}
+	return 0
+}
+
+func (x *ItemShortfall) GetAvailable() int32 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+type UpdateEnergyConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *EnergyConfig          `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEnergyConfigResponse) Reset() {
+	*x = UpdateEnergyConfigResponse{}
+	mi := &file_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEnergyConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEnergyConfigResponse) ProtoMessage() {}
+
+func (x *UpdateEnergyConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEnergyConfigResponse.ProtoReflect.Descriptor instead.
+func (*UpdateEnergyConfigResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UpdateEnergyConfigResponse) GetConfig() *EnergyConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *UpdateEnergyConfigResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateEnergyConfigResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SetEnergyLevelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EnergyState   *EnergyState           `protobuf:"bytes,1,opt,name=energy_state,json=energyState,proto3" json:"energy_state,omitempty"`
+	Config        *EnergyConfig          `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetEnergyLevelResponse) Reset() {
+	*x = SetEnergyLevelResponse{}
+	mi := &file_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEnergyLevelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEnergyLevelResponse) ProtoMessage() {}
+
+func (x *SetEnergyLevelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEnergyLevelResponse.ProtoReflect.Descriptor instead.
+func (*SetEnergyLevelResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SetEnergyLevelResponse) GetEnergyState() *EnergyState {
+	if x != nil {
+		return x.EnergyState
+	}
+	return nil
+}
+
+func (x *SetEnergyLevelResponse) GetConfig() *EnergyConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *SetEnergyLevelResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetEnergyLevelResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ResetEnergyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EnergyState   *EnergyState           `protobuf:"bytes,1,opt,name=energy_state,json=energyState,proto3" json:"energy_state,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetEnergyResponse) Reset() {
+	*x = ResetEnergyResponse{}
+	mi := &file_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetEnergyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetEnergyResponse) ProtoMessage() {}
+
+func (x *ResetEnergyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetEnergyResponse.ProtoReflect.Descriptor instead.
+func (*ResetEnergyResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ResetEnergyResponse) GetEnergyState() *EnergyState {
+	if x != nil {
+		return x.EnergyState
+	}
+	return nil
+}
+
+func (x *ResetEnergyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ResetEnergyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type UpdateInventoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*InventoryItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // Inventory after the change, unchanged on failure
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Shortfalls    []*ItemShortfall       `protobuf:"bytes,4,rep,name=shortfalls,proto3" json:"shortfalls,omitempty"`                         // Items held in too small a quantity, when not successful
+	PendingItems  []*InventoryItem       `protobuf:"bytes,5,rep,name=pending_items,json=pendingItems,proto3" json:"pending_items,omitempty"` // Loot waiting to be claimed, set when claiming
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateInventoryResponse) Reset() {
+	*x = UpdateInventoryResponse{}
+	mi := &file_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateInventoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInventoryResponse) ProtoMessage() {}
+
+func (x *UpdateInventoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInventoryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateInventoryResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *UpdateInventoryResponse) GetItems() []*InventoryItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *UpdateInventoryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateInventoryResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateInventoryResponse) GetShortfalls() []*ItemShortfall {
+	if x != nil {
+		return x.Shortfalls
+	}
+	return nil
+}
+
+func (x *UpdateInventoryResponse) GetPendingItems() []*InventoryItem {
+	if x != nil {
+		return x.PendingItems
+	}
+	return nil
+}
+
+type ListEnergyHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*LedgerEntry         `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`                         // Newest first
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // Cursor of the next page, empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEnergyHistoryResponse) Reset() {
+	*x = ListEnergyHistoryResponse{}
+	mi := &file_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEnergyHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEnergyHistoryResponse) ProtoMessage() {}
+
+func (x *ListEnergyHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEnergyHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListEnergyHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListEnergyHistoryResponse) GetEntries() []*LedgerEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListEnergyHistoryResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetNamespaceEnergyConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *NamespaceEnergyConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNamespaceEnergyConfigResponse) Reset() {
+	*x = GetNamespaceEnergyConfigResponse{}
+	mi := &file_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNamespaceEnergyConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespaceEnergyConfigResponse) ProtoMessage() {}
+
+func (x *GetNamespaceEnergyConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespaceEnergyConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetNamespaceEnergyConfigResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetNamespaceEnergyConfigResponse) GetConfig() *NamespaceEnergyConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type RegenBoostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Boost         *RegenBoost            `protobuf:"bytes,1,opt,name=boost,proto3" json:"boost,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenBoostResponse) Reset() {
+	*x = RegenBoostResponse{}
+	mi := &file_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenBoostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenBoostResponse) ProtoMessage() {}
+
+func (x *RegenBoostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenBoostResponse.ProtoReflect.Descriptor instead.
+func (*RegenBoostResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RegenBoostResponse) GetBoost() *RegenBoost {
+	if x != nil {
+		return x.Boost
+	}
+	return nil
+}
+
+func (x *RegenBoostResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RegenBoostResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListRegenBoostsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Boosts        []*RegenBoost          `protobuf:"bytes,1,rep,name=boosts,proto3" json:"boosts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRegenBoostsResponse) Reset() {
+	*x = ListRegenBoostsResponse{}
+	mi := &file_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRegenBoostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRegenBoostsResponse) ProtoMessage() {}
+
+func (x *ListRegenBoostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRegenBoostsResponse.ProtoReflect.Descriptor instead.
+func (*ListRegenBoostsResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListRegenBoostsResponse) GetBoosts() []*RegenBoost {
+	if x != nil {
+		return x.Boosts
+	}
+	return nil
+}
+
+type DebugTimeOffsetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	OffsetSeconds int64                  `protobuf:"varint,2,opt,name=offset_seconds,json=offsetSeconds,proto3" json:"offset_seconds,omitempty"` // How far ahead of real time the namespace runs
+	ServerTime    int64                  `protobuf:"varint,3,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`          // Unix timestamp of the service clock
+	EffectiveTime int64                  `protobuf:"varint,4,opt,name=effective_time,json=effectiveTime,proto3" json:"effective_time,omitempty"` // Unix timestamp players in the namespace see
+	Enabled       bool                   `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`                                  // Whether the service applies debug time offsets
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DebugTimeOffsetResponse) Reset() {
+	*x = DebugTimeOffsetResponse{}
+	mi := &file_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DebugTimeOffsetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugTimeOffsetResponse) ProtoMessage() {}
+
+func (x *DebugTimeOffsetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugTimeOffsetResponse.ProtoReflect.Descriptor instead.
+func (*DebugTimeOffsetResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DebugTimeOffsetResponse) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DebugTimeOffsetResponse) GetOffsetSeconds() int64 {
+	if x != nil {
+		return x.OffsetSeconds
+	}
+	return 0
+}
+
+func (x *DebugTimeOffsetResponse) GetServerTime() int64 {
+	if x != nil {
+		return x.ServerTime
+	}
+	return 0
+}
+
+func (x *DebugTimeOffsetResponse) GetEffectiveTime() int64 {
+	if x != nil {
+		return x.EffectiveTime
+	}
+	return 0
+}
+
+func (x *DebugTimeOffsetResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type UpdateNamespaceEnergyConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *NamespaceEnergyConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNamespaceEnergyConfigResponse) Reset() {
+	*x = UpdateNamespaceEnergyConfigResponse{}
+	mi := &file_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNamespaceEnergyConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNamespaceEnergyConfigResponse) ProtoMessage() {}
+
+func (x *UpdateNamespaceEnergyConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNamespaceEnergyConfigResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNamespaceEnergyConfigResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UpdateNamespaceEnergyConfigResponse) GetConfig() *NamespaceEnergyConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *UpdateNamespaceEnergyConfigResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateNamespaceEnergyConfigResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListItemCatalogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*CatalogItem         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"` // Economy config version, changes when the catalog may have changed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListItemCatalogResponse) Reset() {
+	*x = ListItemCatalogResponse{}
+	mi := &file_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListItemCatalogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListItemCatalogResponse) ProtoMessage() {}
+
+func (x *ListItemCatalogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListItemCatalogResponse.ProtoReflect.Descriptor instead.
+func (*ListItemCatalogResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListItemCatalogResponse) GetItems() []*CatalogItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListItemCatalogResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type CraftItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EnergyState   *EnergyState           `protobuf:"bytes,1,opt,name=energy_state,json=energyState,proto3" json:"energy_state,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"` // Whether the inputs and energy were spent
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Crafted       bool                   `protobuf:"varint,4,opt,name=crafted,proto3" json:"crafted,omitempty"`      // Whether crafting succeeded and the outputs were granted
+	Outputs       []*LootItem            `protobuf:"bytes,5,rep,name=outputs,proto3" json:"outputs,omitempty"`       // Items granted, empty when crafting failed
+	Items         []*InventoryItem       `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`           // Inventory after crafting
+	Pools         []*EnergyState         `protobuf:"bytes,7,rep,name=pools,proto3" json:"pools,omitempty"`           // Every energy pool the recipe costs
+	Shortfalls    []*ItemShortfall       `protobuf:"bytes,8,rep,name=shortfalls,proto3" json:"shortfalls,omitempty"` // Inputs held in too small a quantity, when not successful
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CraftItemResponse) Reset() {
+	*x = CraftItemResponse{}
+	mi := &file_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CraftItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CraftItemResponse) ProtoMessage() {}
+
+func (x *CraftItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CraftItemResponse.ProtoReflect.Descriptor instead.
+func (*CraftItemResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CraftItemResponse) GetEnergyState() *EnergyState {
+	if x != nil {
+		return x.EnergyState
+	}
+	return nil
+}
+
+func (x *CraftItemResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CraftItemResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CraftItemResponse) GetCrafted() bool {
+	if x != nil {
+		return x.Crafted
+	}
+	return false
+}
+
+func (x *CraftItemResponse) GetOutputs() []*LootItem {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *CraftItemResponse) GetItems() []*InventoryItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CraftItemResponse) GetPools() []*EnergyState {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+func (x *CraftItemResponse) GetShortfalls() []*ItemShortfall {
+	if x != nil {
+		return x.Shortfalls
+	}
+	return nil
+}
+
+type ListRecipesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recipes       []*Recipe              `protobuf:"bytes,1,rep,name=recipes,proto3" json:"recipes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecipesResponse) Reset() {
+	*x = ListRecipesResponse{}
+	mi := &file_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecipesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecipesResponse) ProtoMessage() {}
+
+func (x *ListRecipesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecipesResponse.ProtoReflect.Descriptor instead.
+func (*ListRecipesResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListRecipesResponse) GetRecipes() []*Recipe {
+	if x != nil {
+		return x.Recipes
+	}
+	return nil
+}
+
+type ExchangeForEnergyResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	EnergyState       *EnergyState           `protobuf:"bytes,1,opt,name=energy_state,json=energyState,proto3" json:"energy_state,omitempty"`
+	Success           bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message           string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Pools             []*EnergyState         `protobuf:"bytes,4,rep,name=pools,proto3" json:"pools,omitempty"`                                                     // Every energy pool the offer credits
+	Items             []*InventoryItem       `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`                                                     // Inventory after the exchange
+	Shortfalls        []*ItemShortfall       `protobuf:"bytes,6,rep,name=shortfalls,proto3" json:"shortfalls,omitempty"`                                           // Price items held in too small a quantity, when not successful
+	NextAvailableTime int64                  `protobuf:"varint,7,opt,name=next_available_time,json=nextAvailableTime,proto3" json:"next_available_time,omitempty"` // Unix timestamp the player can next use the offer, 0 if available now
+	UsesToday         int32                  `protobuf:"varint,8,opt,name=uses_today,json=usesToday,proto3" json:"uses_today,omitempty"`                           // Uses of the offer today, counted for offers with a daily limit
+	MaxUsesPerDay     int32                  `protobuf:"varint,9,opt,name=max_uses_per_day,json=maxUsesPerDay,proto3" json:"max_uses_per_day,omitempty"`           // Daily limit of the offer, 0 = unlimited
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ExchangeForEnergyResponse) Reset() {
+	*x = ExchangeForEnergyResponse{}
+	mi := &file_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeForEnergyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeForEnergyResponse) ProtoMessage() {}
+
+func (x *ExchangeForEnergyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeForEnergyResponse.ProtoReflect.Descriptor instead.
+func (*ExchangeForEnergyResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ExchangeForEnergyResponse) GetEnergyState() *EnergyState {
+	if x != nil {
+		return x.EnergyState
+	}
+	return nil
+}
+
+func (x *ExchangeForEnergyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ExchangeForEnergyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ExchangeForEnergyResponse) GetPools() []*EnergyState {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+func (x *ExchangeForEnergyResponse) GetItems() []*InventoryItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ExchangeForEnergyResponse) GetShortfalls() []*ItemShortfall {
+	if x != nil {
+		return x.Shortfalls
+	}
+	return nil
+}
+
+func (x *ExchangeForEnergyResponse) GetNextAvailableTime() int64 {
+	if x != nil {
+		return x.NextAvailableTime
+	}
+	return 0
+}
+
+func (x *ExchangeForEnergyResponse) GetUsesToday() int32 {
+	if x != nil {
+		return x.UsesToday
+	}
+	return 0
+}
+
+func (x *ExchangeForEnergyResponse) GetMaxUsesPerDay() int32 {
+	if x != nil {
+		return x.MaxUsesPerDay
+	}
+	return 0
+}
+
+type EnergyState struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	UserId                 string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CurrentEnergy          int32                  `protobuf:"varint,2,opt,name=current_energy,json=currentEnergy,proto3" json:"current_energy,omitempty"`                               // Current energy amount, including overflow_energy
+	MaxEnergy              int32                  `protobuf:"varint,3,opt,name=max_energy,json=maxEnergy,proto3" json:"max_energy,omitempty"`                                           // Maximum energy capacity
+	LastUpdateTime         int64                  `protobuf:"varint,4,opt,name=last_update_time,json=lastUpdateTime,proto3" json:"last_update_time,omitempty"`                          // Unix timestamp of last update, regen progress made before it is carried over
+	RegenRateSeconds       int32                  `protobuf:"varint,5,opt,name=regen_rate_seconds,json=regenRateSeconds,proto3" json:"regen_rate_seconds,omitempty"`                    // Seconds per energy point
+	NextRegenTime          int64                  `protobuf:"varint,6,opt,name=next_regen_time,json=nextRegenTime,proto3" json:"next_regen_time,omitempty"`                             // Unix timestamp when next energy regenerates
+	EnergyToMax            int32                  `protobuf:"varint,7,opt,name=energy_to_max,json=energyToMax,proto3" json:"energy_to_max,omitempty"`                                   // Energy needed to reach max
+	TimeToMaxSeconds       int64                  `protobuf:"varint,8,opt,name=time_to_max_seconds,json=timeToMaxSeconds,proto3" json:"time_to_max_seconds,omitempty"`                  // Seconds until full
+	PoolId                 string                 `protobuf:"bytes,9,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`                                                     // Energy pool, "energy" is the default pool
+	OverflowEnergy         int32                  `protobuf:"varint,10,opt,name=overflow_energy,json=overflowEnergy,proto3" json:"overflow_energy,omitempty"`                           // Energy above max_energy from purchases or gifts, regen is paused while above max
+	RegenMultiplierPercent int32                  `protobuf:"varint,11,opt,name=regen_multiplier_percent,json=regenMultiplierPercent,proto3" json:"regen_multiplier_percent,omitempty"` // Current regen speed including boosts, 100 = normal
+	NextRegenTimeMs        int64                  `protobuf:"varint,12,opt,name=next_regen_time_ms,json=nextRegenTimeMs,proto3" json:"next_regen_time_ms,omitempty"`                    // next_regen_time in Unix milliseconds
+	TimeToMaxMs            int64                  `protobuf:"varint,13,opt,name=time_to_max_ms,json=timeToMaxMs,proto3" json:"time_to_max_ms,omitempty"`                                // time_to_max_seconds in milliseconds
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *EnergyState) Reset() {
+	*x = EnergyState{}
+	mi := &file_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnergyState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnergyState) ProtoMessage() {}
+
+func (x *EnergyState) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnergyState.ProtoReflect.Descriptor instead.
+func (*EnergyState) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *EnergyState) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EnergyState) GetCurrentEnergy() int32 {
+	if x != nil {
+		return x.CurrentEnergy
+	}
+	return 0
+}
+
+func (x *EnergyState) GetMaxEnergy() int32 {
+	if x != nil {
+		return x.MaxEnergy
+	}
+	return 0
+}
+
+func (x *EnergyState) GetLastUpdateTime() int64 {
+	if x != nil {
+		return x.LastUpdateTime
+	}
+	return 0
+}
+
+func (x *EnergyState) GetRegenRateSeconds() int32 {
+	if x != nil {
+		return x.RegenRateSeconds
+	}
+	return 0
+}
+
+func (x *EnergyState) GetNextRegenTime() int64 {
+	if x != nil {
+		return x.NextRegenTime
+	}
+	return 0
+}
+
+func (x *EnergyState) GetEnergyToMax() int32 {
+	if x != nil {
+		return x.EnergyToMax
+	}
+	return 0
+}
+
+func (x *EnergyState) GetTimeToMaxSeconds() int64 {
+	if x != nil {
+		return x.TimeToMaxSeconds
+	}
+	return 0
+}
+
+func (x *EnergyState) GetPoolId() string {
+	if x != nil {
+		return x.PoolId
+	}
+	return ""
+}
+
+func (x *EnergyState) GetOverflowEnergy() int32 {
+	if x != nil {
+		return x.OverflowEnergy
+	}
+	return 0
+}
+
+func (x *EnergyState) GetRegenMultiplierPercent() int32 {
+	if x != nil {
+		return x.RegenMultiplierPercent
+	}
+	return 0
+}
+
+func (x *EnergyState) GetNextRegenTimeMs() int64 {
+	if x != nil {
+		return x.NextRegenTimeMs
+	}
+	return 0
+}
+
+func (x *EnergyState) GetTimeToMaxMs() int64 {
+	if x != nil {
+		return x.TimeToMaxMs
+	}
+	return 0
+}
+
+// Energy deducted for an action that has not resolved yet
+type EnergyHold struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HoldId        string                 `protobuf:"bytes,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	ActionType    string                 `protobuf:"bytes,2,opt,name=action_type,json=actionType,proto3" json:"action_type,omitempty"`
+	Costs         map[string]int32       `protobuf:"bytes,3,rep,name=costs,proto3" json:"costs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // pool_id -> energy held
+	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                                  // Unix timestamp
+	ExpiresAt     int64                  `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                                                  // Unix timestamp, the hold is refunded afterwards
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnergyHold) Reset() {
+	*x = EnergyHold{}
+	mi := &file_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnergyHold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnergyHold) ProtoMessage() {}
+
+func (x *EnergyHold) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnergyHold.ProtoReflect.Descriptor instead.
+func (*EnergyHold) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *EnergyHold) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *EnergyHold) GetActionType() string {
+	if x != nil {
+		return x.ActionType
+	}
+	return ""
+}
+
+func (x *EnergyHold) GetCosts() map[string]int32 {
+	if x != nil {
+		return x.Costs
+	}
+	return nil
+}
+
+func (x *EnergyHold) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *EnergyHold) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+// A change of a player's energy or inventory
+type LedgerEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       string                 `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                        // Unix timestamp
+	TimestampMs   int64                  `protobuf:"varint,3,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"` // timestamp in Unix milliseconds
+	Operation     string                 `protobuf:"bytes,4,opt,name=operation,proto3" json:"operation,omitempty"`                         // Request that made the change, e.g. ConsumeMyEnergy
+	Actor         string                 `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`                                 // player, admin, or system for expired holds
+	ActorId       string                 `protobuf:"bytes,6,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`              // User or client ID of the caller
+	ActionType    string                 `protobuf:"bytes,7,opt,name=action_type,json=actionType,proto3" json:"action_type,omitempty"`
+	ActionId      string                 `protobuf:"bytes,8,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"` // e.g. the hold or regen boost ID
+	Source        string                 `protobuf:"bytes,9,opt,name=source,proto3" json:"source,omitempty"`                     // Refill source
+	TransactionId string                 `protobuf:"bytes,10,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Pools         []*LedgerPoolChange    `protobuf:"bytes,11,rep,name=pools,proto3" json:"pools,omitempty"`   // Every energy pool whose energy changed
+	Items         []*LootItem            `protobuf:"bytes,12,rep,name=items,proto3" json:"items,omitempty"`   // Inventory changes, loot granted is positive and items spent negative
+	Reason        string                 `protobuf:"bytes,13,opt,name=reason,proto3" json:"reason,omitempty"` // Reason given for an item spend, grant or revoke
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LedgerEntry) Reset() {
+	*x = LedgerEntry{}
+	mi := &file_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerEntry) ProtoMessage() {}
+
+func (x *LedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerEntry.ProtoReflect.Descriptor instead.
+func (*LedgerEntry) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *LedgerEntry) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetActionType() string {
+	if x != nil {
+		return x.ActionType
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetActionId() string {
+	if x != nil {
+		return x.ActionId
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetPools() []*LedgerPoolChange {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+func (x *LedgerEntry) GetItems() []*LootItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *LedgerEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Change of one energy pool in a ledger entry
+type LedgerPoolChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PoolId        string                 `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Delta         int32                  `protobuf:"varint,2,opt,name=delta,proto3" json:"delta,omitempty"`
+	BalanceBefore int32                  `protobuf:"varint,3,opt,name=balance_before,json=balanceBefore,proto3" json:"balance_before,omitempty"`
+	BalanceAfter  int32                  `protobuf:"varint,4,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LedgerPoolChange) Reset() {
+	*x = LedgerPoolChange{}
+	mi := &file_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerPoolChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerPoolChange) ProtoMessage() {}
+
+func (x *LedgerPoolChange) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerPoolChange.ProtoReflect.Descriptor instead.
+func (*LedgerPoolChange) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *LedgerPoolChange) GetPoolId() string {
+	if x != nil {
+		return x.PoolId
+	}
+	return ""
+}
+
+func (x *LedgerPoolChange) GetDelta() int32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *LedgerPoolChange) GetBalanceBefore() int32 {
+	if x != nil {
+		return x.BalanceBefore
+	}
+	return 0
+}
+
+func (x *LedgerPoolChange) GetBalanceAfter() int32 {
+	if x != nil {
+		return x.BalanceAfter
+	}
+	return 0
+}
+
+// Time-bounded regen speed modifier
+type RegenBoost struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	BoostId           string                 `protobuf:"bytes,1,opt,name=boost_id,json=boostId,proto3" json:"boost_id,omitempty"`
+	UserId            string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                   // Boosted player, empty for a namespace-wide event
+	MultiplierPercent int32                  `protobuf:"varint,3,opt,name=multiplier_percent,json=multiplierPercent,proto3" json:"multiplier_percent,omitempty"` // Regen speed during the boost, e.g. 200 = twice as fast
+	PoolId            string                 `protobuf:"bytes,4,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`                                   // Boosted energy pool, empty for every pool
+	StartTime         int64                  `protobuf:"varint,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`                         // Unix timestamp
+	EndTime           int64                  `protobuf:"varint,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`                               // Unix timestamp
+	Reason            string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RegenBoost) Reset() {
+	*x = RegenBoost{}
+	mi := &file_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenBoost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenBoost) ProtoMessage() {}
+
+func (x *RegenBoost) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenBoost.ProtoReflect.Descriptor instead.
+func (*RegenBoost) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *RegenBoost) GetBoostId() string {
+	if x != nil {
+		return x.BoostId
+	}
+	return ""
+}
+
+func (x *RegenBoost) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RegenBoost) GetMultiplierPercent() int32 {
+	if x != nil {
+		return x.MultiplierPercent
+	}
+	return 0
+}
+
+func (x *RegenBoost) GetPoolId() string {
+	if x != nil {
+		return x.PoolId
+	}
+	return ""
+}
+
+func (x *RegenBoost) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *RegenBoost) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *RegenBoost) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type EnergyConfig struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	UserId              string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MaxEnergy           int32                  `protobuf:"varint,2,opt,name=max_energy,json=maxEnergy,proto3" json:"max_energy,omitempty"`                                 // Maximum energy capacity
+	RegenRateSeconds    int32                  `protobuf:"varint,3,opt,name=regen_rate_seconds,json=regenRateSeconds,proto3" json:"regen_rate_seconds,omitempty"`          // Seconds per energy point
+	Level               int32                  `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`                                                          // Energy system level
+	MaxEnergyOverridden bool                   `protobuf:"varint,5,opt,name=max_energy_overridden,json=maxEnergyOverridden,proto3" json:"max_energy_overridden,omitempty"` // max_energy is a per-user override rather than the level's value
+	RegenRateOverridden bool                   `protobuf:"varint,6,opt,name=regen_rate_overridden,json=regenRateOverridden,proto3" json:"regen_rate_overridden,omitempty"` // regen_rate_seconds is a per-user override rather than the level's value
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *EnergyConfig) Reset() {
+	*x = EnergyConfig{}
+	mi := &file_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnergyConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnergyConfig) ProtoMessage() {}
+
+func (x *EnergyConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnergyConfig.ProtoReflect.Descriptor instead.
+func (*EnergyConfig) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *EnergyConfig) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EnergyConfig) GetMaxEnergy() int32 {
+	if x != nil {
+		return x.MaxEnergy
+	}
+	return 0
+}
+
+func (x *EnergyConfig) GetRegenRateSeconds() int32 {
+	if x != nil {
+		return x.RegenRateSeconds
+	}
+	return 0
+}
+
+func (x *EnergyConfig) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *EnergyConfig) GetMaxEnergyOverridden() bool {
+	if x != nil {
+		return x.MaxEnergyOverridden
+	}
+	return false
+}
+
+func (x *EnergyConfig) GetRegenRateOverridden() bool {
+	if x != nil {
+		return x.RegenRateOverridden
+	}
+	return false
+}
+
+// Defaults for new players in a namespace
+type NamespaceEnergyConfig struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Namespace        string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	MaxEnergy        int32                  `protobuf:"varint,2,opt,name=max_energy,json=maxEnergy,proto3" json:"max_energy,omitempty"`                        // Maximum energy capacity
+	RegenRateSeconds int32                  `protobuf:"varint,3,opt,name=regen_rate_seconds,json=regenRateSeconds,proto3" json:"regen_rate_seconds,omitempty"` // Seconds per energy point
+	StartingEnergy   int32                  `protobuf:"varint,4,opt,name=starting_energy,json=startingEnergy,proto3" json:"starting_energy,omitempty"`         // Energy a new player starts with
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *NamespaceEnergyConfig) Reset() {
+	*x = NamespaceEnergyConfig{}
+	mi := &file_service_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResetEnergyResponse) String() string {
+func (x *NamespaceEnergyConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetEnergyResponse) ProtoMessage() {}
+func (*NamespaceEnergyConfig) ProtoMessage() {}
 
-func (x *ResetEnergyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[19]
+func (x *NamespaceEnergyConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1163,61 +4842,70 @@ func (x *ResetEnergyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetEnergyResponse.ProtoReflect.Descriptor instead.
-func (*ResetEnergyResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use NamespaceEnergyConfig.ProtoReflect.Descriptor instead.
+func (*NamespaceEnergyConfig) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *ResetEnergyResponse) GetEnergyState() *EnergyState {
+func (x *NamespaceEnergyConfig) GetNamespace() string {
 	if x != nil {
-		return x.EnergyState
+		return x.Namespace
 	}
-	return nil
+	return ""
 }
 
-func (x *ResetEnergyResponse) GetSuccess() bool {
+func (x *NamespaceEnergyConfig) GetMaxEnergy() int32 {
 	if x != nil {
-		return x.Success
+		return x.MaxEnergy
 	}
-	return false
+	return 0
 }
 
-func (x *ResetEnergyResponse) GetMessage() string {
+func (x *NamespaceEnergyConfig) GetRegenRateSeconds() int32 {
 	if x != nil {
-		return x.Message
+		return x.RegenRateSeconds
 	}
-	return ""
+	return 0
 }
 
-type EnergyState struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CurrentEnergy    int32                  `protobuf:"varint,2,opt,name=current_energy,json=currentEnergy,proto3" json:"current_energy,omitempty"`              // Current energy amount
-	MaxEnergy        int32                  `protobuf:"varint,3,opt,name=max_energy,json=maxEnergy,proto3" json:"max_energy,omitempty"`                          // Maximum energy capacity
-	LastUpdateTime   int64                  `protobuf:"varint,4,opt,name=last_update_time,json=lastUpdateTime,proto3" json:"last_update_time,omitempty"`         // Unix timestamp of last update
-	RegenRateSeconds int32                  `protobuf:"varint,5,opt,name=regen_rate_seconds,json=regenRateSeconds,proto3" json:"regen_rate_seconds,omitempty"`   // Seconds per energy point
-	NextRegenTime    int64                  `protobuf:"varint,6,opt,name=next_regen_time,json=nextRegenTime,proto3" json:"next_regen_time,omitempty"`            // Unix timestamp when next energy regenerates
-	EnergyToMax      int32                  `protobuf:"varint,7,opt,name=energy_to_max,json=energyToMax,proto3" json:"energy_to_max,omitempty"`                  // Energy needed to reach max
-	TimeToMaxSeconds int64                  `protobuf:"varint,8,opt,name=time_to_max_seconds,json=timeToMaxSeconds,proto3" json:"time_to_max_seconds,omitempty"` // Seconds until full
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *NamespaceEnergyConfig) GetStartingEnergy() int32 {
+	if x != nil {
+		return x.StartingEnergy
+	}
+	return 0
 }
 
-func (x *EnergyState) Reset() {
-	*x = EnergyState{}
-	mi := &file_service_proto_msgTypes[20]
+// Item of the catalog
+type CatalogItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ItemId         string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                                                                                     // Display name in the requested language
+	LocalizedNames map[string]string      `protobuf:"bytes,3,rep,name=localized_names,json=localizedNames,proto3" json:"localized_names,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // language -> display name
+	Rarity         string                 `protobuf:"bytes,4,opt,name=rarity,proto3" json:"rarity,omitempty"`                                                                                                                 // common, uncommon, rare, epic or legendary
+	Category       string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	IconKey        string                 `protobuf:"bytes,6,opt,name=icon_key,json=iconKey,proto3" json:"icon_key,omitempty"`
+	MaxStack       int32                  `protobuf:"varint,7,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"` // Most a player may hold, 0 = unlimited
+	Tradable       bool                   `protobuf:"varint,8,opt,name=tradable,proto3" json:"tradable,omitempty"`
+	Sellable       bool                   `protobuf:"varint,9,opt,name=sellable,proto3" json:"sellable,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CatalogItem) Reset() {
+	*x = CatalogItem{}
+	mi := &file_service_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EnergyState) String() string {
+func (x *CatalogItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnergyState) ProtoMessage() {}
+func (*CatalogItem) ProtoMessage() {}
 
-func (x *EnergyState) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[20]
+func (x *CatalogItem) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1228,92 +4916,106 @@ func (x *EnergyState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnergyState.ProtoReflect.Descriptor instead.
-func (*EnergyState) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use CatalogItem.ProtoReflect.Descriptor instead.
+func (*CatalogItem) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *EnergyState) GetUserId() string {
+func (x *CatalogItem) GetItemId() string {
 	if x != nil {
-		return x.UserId
+		return x.ItemId
 	}
 	return ""
 }
 
-func (x *EnergyState) GetCurrentEnergy() int32 {
+func (x *CatalogItem) GetName() string {
 	if x != nil {
-		return x.CurrentEnergy
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *EnergyState) GetMaxEnergy() int32 {
+func (x *CatalogItem) GetLocalizedNames() map[string]string {
 	if x != nil {
-		return x.MaxEnergy
+		return x.LocalizedNames
 	}
-	return 0
+	return nil
 }
 
-func (x *EnergyState) GetLastUpdateTime() int64 {
+func (x *CatalogItem) GetRarity() string {
 	if x != nil {
-		return x.LastUpdateTime
+		return x.Rarity
 	}
-	return 0
+	return ""
 }
 
-func (x *EnergyState) GetRegenRateSeconds() int32 {
+func (x *CatalogItem) GetCategory() string {
 	if x != nil {
-		return x.RegenRateSeconds
+		return x.Category
 	}
-	return 0
+	return ""
 }
 
-func (x *EnergyState) GetNextRegenTime() int64 {
+func (x *CatalogItem) GetIconKey() string {
 	if x != nil {
-		return x.NextRegenTime
+		return x.IconKey
 	}
-	return 0
+	return ""
 }
 
-func (x *EnergyState) GetEnergyToMax() int32 {
+func (x *CatalogItem) GetMaxStack() int32 {
 	if x != nil {
-		return x.EnergyToMax
+		return x.MaxStack
 	}
 	return 0
 }
 
-func (x *EnergyState) GetTimeToMaxSeconds() int64 {
+func (x *CatalogItem) GetTradable() bool {
 	if x != nil {
-		return x.TimeToMaxSeconds
+		return x.Tradable
 	}
-	return 0
+	return false
 }
 
-type EnergyConfig struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	MaxEnergy        int32                  `protobuf:"varint,2,opt,name=max_energy,json=maxEnergy,proto3" json:"max_energy,omitempty"`                        // Maximum energy capacity
-	RegenRateSeconds int32                  `protobuf:"varint,3,opt,name=regen_rate_seconds,json=regenRateSeconds,proto3" json:"regen_rate_seconds,omitempty"` // Seconds per energy point
-	Level            int32                  `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`                                                 // Energy system level
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *CatalogItem) GetSellable() bool {
+	if x != nil {
+		return x.Sellable
+	}
+	return false
 }
 
-func (x *EnergyConfig) Reset() {
-	*x = EnergyConfig{}
-	mi := &file_service_proto_msgTypes[21]
+// Crafting recipe and whether the player can craft it
+type Recipe struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RecipeId       string                 `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Inputs         []*LootItem            `protobuf:"bytes,3,rep,name=inputs,proto3" json:"inputs,omitempty"`                                                                                                         // Items spent
+	Outputs        []*LootItem            `protobuf:"bytes,4,rep,name=outputs,proto3" json:"outputs,omitempty"`                                                                                                       // Items granted when crafting succeeds
+	EnergyCosts    map[string]int32       `protobuf:"bytes,5,rep,name=energy_costs,json=energyCosts,proto3" json:"energy_costs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // pool_id -> energy spent
+	SuccessPercent int32                  `protobuf:"varint,6,opt,name=success_percent,json=successPercent,proto3" json:"success_percent,omitempty"`                                                                  // Chance crafting succeeds
+	UnlockLevel    int32                  `protobuf:"varint,7,opt,name=unlock_level,json=unlockLevel,proto3" json:"unlock_level,omitempty"`                                                                           // Energy level required
+	Unlocked       bool                   `protobuf:"varint,8,opt,name=unlocked,proto3" json:"unlocked,omitempty"`                                                                                                    // Whether the player's energy level is high enough
+	CanCraft       bool                   `protobuf:"varint,9,opt,name=can_craft,json=canCraft,proto3" json:"can_craft,omitempty"`                                                                                    // Unlocked, the player has every input and enough energy and the outputs fit
+	Shortfalls     []*ItemShortfall       `protobuf:"bytes,10,rep,name=shortfalls,proto3" json:"shortfalls,omitempty"`                                                                                                // Inputs the player holds too few of
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Recipe) Reset() {
+	*x = Recipe{}
+	mi := &file_service_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EnergyConfig) String() string {
+func (x *Recipe) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnergyConfig) ProtoMessage() {}
+func (*Recipe) ProtoMessage() {}
 
-func (x *EnergyConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[21]
+func (x *Recipe) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1324,122 +5026,406 @@ func (x *EnergyConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnergyConfig.ProtoReflect.Descriptor instead.
-func (*EnergyConfig) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use Recipe.ProtoReflect.Descriptor instead.
+func (*Recipe) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *EnergyConfig) GetUserId() string {
+func (x *Recipe) GetRecipeId() string {
 	if x != nil {
-		return x.UserId
+		return x.RecipeId
 	}
 	return ""
 }
 
-func (x *EnergyConfig) GetMaxEnergy() int32 {
+func (x *Recipe) GetName() string {
 	if x != nil {
-		return x.MaxEnergy
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *EnergyConfig) GetRegenRateSeconds() int32 {
+func (x *Recipe) GetInputs() []*LootItem {
 	if x != nil {
-		return x.RegenRateSeconds
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *Recipe) GetOutputs() []*LootItem {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *Recipe) GetEnergyCosts() map[string]int32 {
+	if x != nil {
+		return x.EnergyCosts
+	}
+	return nil
+}
+
+func (x *Recipe) GetSuccessPercent() int32 {
+	if x != nil {
+		return x.SuccessPercent
 	}
 	return 0
 }
 
-func (x *EnergyConfig) GetLevel() int32 {
+func (x *Recipe) GetUnlockLevel() int32 {
 	if x != nil {
-		return x.Level
+		return x.UnlockLevel
 	}
 	return 0
 }
 
+func (x *Recipe) GetUnlocked() bool {
+	if x != nil {
+		return x.Unlocked
+	}
+	return false
+}
+
+func (x *Recipe) GetCanCraft() bool {
+	if x != nil {
+		return x.CanCraft
+	}
+	return false
+}
+
+func (x *Recipe) GetShortfalls() []*ItemShortfall {
+	if x != nil {
+		return x.Shortfalls
+	}
+	return nil
+}
+
 var File_service_proto protoreflect.FileDescriptor
 
 const file_service_proto_rawDesc = "" +
 	"\n" +
-	"\rservice.proto\x12\aservice\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x10permission.proto\"2\n" +
+	"\rservice.proto\x12\aservice\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x10permission.proto\"d\n" +
 	"\x12GetMyEnergyRequest\x12\x1c\n" +
-	"\tnamespace\x18\x01 \x01(\tR\tnamespace\"\x8c\x01\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
+	"\apool_id\x18\x03 \x01(\tR\x06poolId\"\xa5\x01\n" +
 	"\x16ConsumeMyEnergyRequest\x12\x1c\n" +
-	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\x05R\x06amount\x12\x1f\n" +
-	"\vaction_type\x18\x03 \x01(\tR\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x05R\x06amount\x12\x1f\n" +
+	"\vaction_type\x18\x04 \x01(\tR\n" +
 	"actionType\x12\x1b\n" +
-	"\taction_id\x18\x04 \x01(\tR\bactionId\"\xa5\x01\n" +
+	"\taction_id\x18\x05 \x01(\tR\bactionId\"\xac\x01\n" +
+	"\x1bConsumeMyEnergyBatchRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12.\n" +
+	"\aactions\x18\x03 \x03(\v2\x14.service.BatchActionR\aactions\x12&\n" +
+	"\x04mode\x18\x04 \x01(\x0e2\x12.service.BatchModeR\x04mode\"D\n" +
+	"\vBatchAction\x12\x1f\n" +
+	"\vaction_type\x18\x01 \x01(\tR\n" +
+	"actionType\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\x91\x01\n" +
+	"\x13SpendMyItemsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12+\n" +
+	"\x05items\x18\x03 \x03(\v2\x15.service.ItemQuantityR\x05items\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"S\n" +
+	"\x1aClaimMyPendingItemsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xd7\x01\n" +
 	"\x15RefillMyEnergyRequest\x12\x1c\n" +
-	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\x05R\x06amount\x12\x16\n" +
-	"\x06source\x18\x03 \x01(\tR\x06source\x12\x17\n" +
-	"\aitem_id\x18\x04 \x01(\tR\x06itemId\x12%\n" +
-	"\x0etransaction_id\x18\x05 \x01(\tR\rtransactionId\"8\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x05R\x06amount\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12\x17\n" +
+	"\aitem_id\x18\x05 \x01(\tR\x06itemId\x12%\n" +
+	"\x0etransaction_id\x18\x06 \x01(\tR\rtransactionId\x12\x17\n" +
+	"\apool_id\x18\a \x01(\tR\x06poolId\"Q\n" +
 	"\x18GetMyEnergyConfigRequest\x12\x1c\n" +
-	"\tnamespace\x18\x01 \x01(\tR\tnamespace\"5\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"j\n" +
 	"\x15GetMyInventoryRequest\x12\x1c\n" +
-	"\tnamespace\x18\x01 \x01(\tR\tnamespace\"I\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\blanguage\x18\x03 \x01(\tR\blanguage\"O\n" +
+	"\x16LevelUpMyEnergyRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x8f\x01\n" +
+	"\x14ReserveEnergyRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vaction_type\x18\x03 \x01(\tR\n" +
+	"actionType\x12\x1f\n" +
+	"\vttl_seconds\x18\x04 \x01(\x03R\n" +
+	"ttlSeconds\"e\n" +
+	"\x13CommitEnergyRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
+	"\ahold_id\x18\x03 \x01(\tR\x06holdId\"f\n" +
+	"\x14ReleaseEnergyRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
+	"\ahold_id\x18\x03 \x01(\tR\x06holdId\"\xc2\x01\n" +
+	"\x1aListMyEnergyHistoryRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\x03R\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x04 \x01(\x03R\aendTime\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x06 \x01(\tR\x06cursor\"n\n" +
+	"\x16ListItemCatalogRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1a\n" +
+	"\blanguage\x18\x02 \x01(\tR\blanguage\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\"f\n" +
+	"\x10CraftItemRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\trecipe_id\x18\x03 \x01(\tR\brecipeId\"g\n" +
+	"\x12ListRecipesRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\blanguage\x18\x03 \x01(\tR\blanguage\"l\n" +
+	"\x18ExchangeForEnergyRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x19\n" +
+	"\boffer_id\x18\x03 \x01(\tR\aofferId\"b\n" +
 	"\x10GetEnergyRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xa3\x01\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
+	"\apool_id\x18\x03 \x01(\tR\x06poolId\"\xbc\x01\n" +
 	"\x14ConsumeEnergyRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
 	"\x06amount\x18\x03 \x01(\x05R\x06amount\x12\x1f\n" +
 	"\vaction_type\x18\x04 \x01(\tR\n" +
 	"actionType\x12\x1b\n" +
-	"\taction_id\x18\x05 \x01(\tR\bactionId\"\xbc\x01\n" +
+	"\taction_id\x18\x05 \x01(\tR\bactionId\x12\x17\n" +
+	"\apool_id\x18\x06 \x01(\tR\x06poolId\"\xd5\x01\n" +
 	"\x13RefillEnergyRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
 	"\x06amount\x18\x03 \x01(\x05R\x06amount\x12\x16\n" +
 	"\x06source\x18\x04 \x01(\tR\x06source\x12\x17\n" +
 	"\aitem_id\x18\x05 \x01(\tR\x06itemId\x12%\n" +
-	"\x0etransaction_id\x18\x06 \x01(\tR\rtransactionId\"O\n" +
+	"\x0etransaction_id\x18\x06 \x01(\tR\rtransactionId\x12\x17\n" +
+	"\apool_id\x18\a \x01(\tR\x06poolId\"O\n" +
 	"\x16GetEnergyConfigRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x9f\x01\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xc8\x01\n" +
 	"\x19UpdateEnergyConfigRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
 	"\n" +
 	"max_energy\x18\x03 \x01(\x05R\tmaxEnergy\x12,\n" +
-	"\x12regen_rate_seconds\x18\x04 \x01(\x05R\x10regenRateSeconds\"K\n" +
+	"\x12regen_rate_seconds\x18\x04 \x01(\x05R\x10regenRateSeconds\x12'\n" +
+	"\x0fclear_overrides\x18\x05 \x01(\bR\x0eclearOverrides\"d\n" +
+	"\x15SetEnergyLevelRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05level\x18\x03 \x01(\x05R\x05level\"r\n" +
 	"\x12ResetEnergyRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"L\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12%\n" +
+	"\x0ewipe_inventory\x18\x03 \x01(\bR\rwipeInventory\"\x8f\x01\n" +
+	"\x11GrantItemsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12+\n" +
+	"\x05items\x18\x03 \x03(\v2\x15.service.ItemQuantityR\x05items\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\x90\x01\n" +
+	"\x12RevokeItemsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12+\n" +
+	"\x05items\x18\x03 \x03(\v2\x15.service.ItemQuantityR\x05items\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\xc5\x01\n" +
+	"\x1dListEnergyTransactionsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\x03R\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x04 \x01(\x03R\aendTime\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x06 \x01(\tR\x06cursor\"?\n" +
+	"\x1fGetNamespaceEnergyConfigRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\"\xfa\x01\n" +
+	"\x17CreateRegenBoostRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12-\n" +
+	"\x12multiplier_percent\x18\x03 \x01(\x05R\x11multiplierPercent\x12\x17\n" +
+	"\apool_id\x18\x04 \x01(\tR\x06poolId\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\x03R\tstartTime\x12)\n" +
+	"\x10duration_seconds\x18\x06 \x01(\x03R\x0fdurationSeconds\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\"O\n" +
+	"\x16ListRegenBoostsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"k\n" +
+	"\x17CancelRegenBoostRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x19\n" +
+	"\bboost_id\x18\x03 \x01(\tR\aboostId\"9\n" +
+	"\x19GetDebugTimeOffsetRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\"`\n" +
+	"\x19SetDebugTimeOffsetRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12%\n" +
+	"\x0eoffset_seconds\x18\x02 \x01(\x03R\roffsetSeconds\"\xb8\x01\n" +
+	"\"UpdateNamespaceEnergyConfigRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1d\n" +
+	"\n" +
+	"max_energy\x18\x02 \x01(\x05R\tmaxEnergy\x12,\n" +
+	"\x12regen_rate_seconds\x18\x03 \x01(\x05R\x10regenRateSeconds\x12'\n" +
+	"\x0fstarting_energy\x18\x04 \x01(\x05R\x0estartingEnergy\"x\n" +
 	"\x11GetEnergyResponse\x127\n" +
-	"\fenergy_state\x18\x01 \x01(\v2\x14.service.EnergyStateR\venergyState\"\xab\x01\n" +
+	"\fenergy_state\x18\x01 \x01(\v2\x14.service.EnergyStateR\venergyState\x12*\n" +
+	"\x05pools\x18\x02 \x03(\v2\x14.service.EnergyStateR\x05pools\"\xd7\x01\n" +
 	"\x15ConsumeEnergyResponse\x127\n" +
 	"\fenergy_state\x18\x01 \x01(\v2\x14.service.EnergyStateR\venergyState\x12\x18\n" +
 	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x03 \x01(\tR\amessage\x12%\n" +
-	"\x04loot\x18\x04 \x03(\v2\x11.service.LootItemR\x04loot\"\\\n" +
+	"\x04loot\x18\x04 \x03(\v2\x11.service.LootItemR\x04loot\x12*\n" +
+	"\x05pools\x18\x05 \x03(\v2\x14.service.EnergyStateR\x05pools\"\xd9\x01\n" +
+	"\x15ReserveEnergyResponse\x12'\n" +
+	"\x04hold\x18\x01 \x01(\v2\x13.service.EnergyHoldR\x04hold\x127\n" +
+	"\fenergy_state\x18\x02 \x01(\v2\x14.service.EnergyStateR\venergyState\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12*\n" +
+	"\x05pools\x18\x05 \x03(\v2\x14.service.EnergyStateR\x05pools\"\x9a\x01\n" +
+	"\x14CommitEnergyResponse\x12'\n" +
+	"\x04hold\x18\x01 \x01(\v2\x13.service.EnergyHoldR\x04hold\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12%\n" +
+	"\x04loot\x18\x04 \x03(\v2\x11.service.LootItemR\x04loot\"\xa0\x01\n" +
+	"\x15ReleaseEnergyResponse\x12'\n" +
+	"\x04hold\x18\x01 \x01(\v2\x13.service.EnergyHoldR\x04hold\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12*\n" +
+	"\x05pools\x18\x04 \x03(\v2\x14.service.EnergyStateR\x05pools\"\x92\x02\n" +
+	"\x1aConsumeEnergyBatchResponse\x127\n" +
+	"\fenergy_state\x18\x01 \x01(\v2\x14.service.EnergyStateR\venergyState\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12%\n" +
+	"\x04loot\x18\x04 \x03(\v2\x11.service.LootItemR\x04loot\x12*\n" +
+	"\x05pools\x18\x05 \x03(\v2\x14.service.EnergyStateR\x05pools\x124\n" +
+	"\aresults\x18\x06 \x03(\v2\x1a.service.BatchActionResultR\aresults\"\x8e\x02\n" +
+	"\x11BatchActionResult\x12\x1f\n" +
+	"\vaction_type\x18\x01 \x01(\tR\n" +
+	"actionType\x12\x1c\n" +
+	"\trequested\x18\x02 \x01(\x05R\trequested\x12\x1c\n" +
+	"\tperformed\x18\x03 \x01(\x05R\tperformed\x12;\n" +
+	"\x05costs\x18\x04 \x03(\v2%.service.BatchActionResult.CostsEntryR\x05costs\x12%\n" +
+	"\x04loot\x18\x05 \x03(\v2\x11.service.LootItemR\x04loot\x1a8\n" +
+	"\n" +
+	"CostsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\x92\x01\n" +
 	"\bLootItem\x12\x17\n" +
 	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\x1b\n" +
 	"\titem_name\x18\x02 \x01(\tR\bitemName\x12\x1a\n" +
-	"\bquantity\x18\x03 \x01(\x05R\bquantity\"\x83\x01\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x18\n" +
+	"\agranted\x18\x04 \x01(\x05R\agranted\x12\x1a\n" +
+	"\boverflow\x18\x05 \x01(\tR\boverflow\"\xa7\x02\n" +
 	"\x14RefillEnergyResponse\x127\n" +
 	"\fenergy_state\x18\x01 \x01(\v2\x14.service.EnergyStateR\venergyState\x12\x18\n" +
 	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"H\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12.\n" +
+	"\x13next_available_time\x18\x04 \x01(\x03R\x11nextAvailableTime\x12\x1d\n" +
+	"\n" +
+	"uses_today\x18\x05 \x01(\x05R\tusesToday\x12'\n" +
+	"\x10max_uses_per_day\x18\x06 \x01(\x05R\rmaxUsesPerDay\x12*\n" +
+	"\x05pools\x18\a \x03(\v2\x14.service.EnergyStateR\x05pools\"H\n" +
 	"\x17GetEnergyConfigResponse\x12-\n" +
-	"\x06config\x18\x01 \x01(\v2\x15.service.EnergyConfigR\x06config\"D\n" +
+	"\x06config\x18\x01 \x01(\v2\x15.service.EnergyConfigR\x06config\"\x81\x01\n" +
 	"\x14GetInventoryResponse\x12,\n" +
-	"\x05items\x18\x01 \x03(\v2\x16.service.InventoryItemR\x05items\"a\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.service.InventoryItemR\x05items\x12;\n" +
+	"\rpending_items\x18\x02 \x03(\v2\x16.service.InventoryItemR\fpendingItems\"\xb0\x01\n" +
 	"\rInventoryItem\x12\x17\n" +
 	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\x1b\n" +
 	"\titem_name\x18\x02 \x01(\tR\bitemName\x12\x1a\n" +
-	"\bquantity\x18\x03 \x01(\x05R\bquantity\"\x7f\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x16\n" +
+	"\x06rarity\x18\x04 \x01(\tR\x06rarity\x12\x1a\n" +
+	"\bcategory\x18\x05 \x01(\tR\bcategory\x12\x19\n" +
+	"\bicon_key\x18\x06 \x01(\tR\aiconKey\"C\n" +
+	"\fItemQuantity\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"b\n" +
+	"\rItemShortfall\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\x1a\n" +
+	"\brequired\x18\x02 \x01(\x05R\brequired\x12\x1c\n" +
+	"\tavailable\x18\x03 \x01(\x05R\tavailable\"\x7f\n" +
 	"\x1aUpdateEnergyConfigResponse\x12-\n" +
 	"\x06config\x18\x01 \x01(\v2\x15.service.EnergyConfigR\x06config\x12\x18\n" +
 	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"\x82\x01\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xb4\x01\n" +
+	"\x16SetEnergyLevelResponse\x127\n" +
+	"\fenergy_state\x18\x01 \x01(\v2\x14.service.EnergyStateR\venergyState\x12-\n" +
+	"\x06config\x18\x02 \x01(\v2\x15.service.EnergyConfigR\x06config\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\x82\x01\n" +
 	"\x13ResetEnergyResponse\x127\n" +
 	"\fenergy_state\x18\x01 \x01(\v2\x14.service.EnergyStateR\venergyState\x12\x18\n" +
 	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"\xbf\x02\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xf0\x01\n" +
+	"\x17UpdateInventoryResponse\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.service.InventoryItemR\x05items\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x126\n" +
+	"\n" +
+	"shortfalls\x18\x04 \x03(\v2\x16.service.ItemShortfallR\n" +
+	"shortfalls\x12;\n" +
+	"\rpending_items\x18\x05 \x03(\v2\x16.service.InventoryItemR\fpendingItems\"l\n" +
+	"\x19ListEnergyHistoryResponse\x12.\n" +
+	"\aentries\x18\x01 \x03(\v2\x14.service.LedgerEntryR\aentries\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"Z\n" +
+	" GetNamespaceEnergyConfigResponse\x126\n" +
+	"\x06config\x18\x01 \x01(\v2\x1e.service.NamespaceEnergyConfigR\x06config\"s\n" +
+	"\x12RegenBoostResponse\x12)\n" +
+	"\x05boost\x18\x01 \x01(\v2\x13.service.RegenBoostR\x05boost\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"F\n" +
+	"\x17ListRegenBoostsResponse\x12+\n" +
+	"\x06boosts\x18\x01 \x03(\v2\x13.service.RegenBoostR\x06boosts\"\xc0\x01\n" +
+	"\x17DebugTimeOffsetResponse\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12%\n" +
+	"\x0eoffset_seconds\x18\x02 \x01(\x03R\roffsetSeconds\x12\x1f\n" +
+	"\vserver_time\x18\x03 \x01(\x03R\n" +
+	"serverTime\x12%\n" +
+	"\x0eeffective_time\x18\x04 \x01(\x03R\reffectiveTime\x12\x18\n" +
+	"\aenabled\x18\x05 \x01(\bR\aenabled\"\x91\x01\n" +
+	"#UpdateNamespaceEnergyConfigResponse\x126\n" +
+	"\x06config\x18\x01 \x01(\v2\x1e.service.NamespaceEnergyConfigR\x06config\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"_\n" +
+	"\x17ListItemCatalogResponse\x12*\n" +
+	"\x05items\x18\x01 \x03(\v2\x14.service.CatalogItemR\x05items\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\"\xd9\x02\n" +
+	"\x11CraftItemResponse\x127\n" +
+	"\fenergy_state\x18\x01 \x01(\v2\x14.service.EnergyStateR\venergyState\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x18\n" +
+	"\acrafted\x18\x04 \x01(\bR\acrafted\x12+\n" +
+	"\aoutputs\x18\x05 \x03(\v2\x11.service.LootItemR\aoutputs\x12,\n" +
+	"\x05items\x18\x06 \x03(\v2\x16.service.InventoryItemR\x05items\x12*\n" +
+	"\x05pools\x18\a \x03(\v2\x14.service.EnergyStateR\x05pools\x126\n" +
+	"\n" +
+	"shortfalls\x18\b \x03(\v2\x16.service.ItemShortfallR\n" +
+	"shortfalls\"@\n" +
+	"\x13ListRecipesResponse\x12)\n" +
+	"\arecipes\x18\x01 \x03(\v2\x0f.service.RecipeR\arecipes\"\x92\x03\n" +
+	"\x19ExchangeForEnergyResponse\x127\n" +
+	"\fenergy_state\x18\x01 \x01(\v2\x14.service.EnergyStateR\venergyState\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12*\n" +
+	"\x05pools\x18\x04 \x03(\v2\x14.service.EnergyStateR\x05pools\x12,\n" +
+	"\x05items\x18\x05 \x03(\v2\x16.service.InventoryItemR\x05items\x126\n" +
+	"\n" +
+	"shortfalls\x18\x06 \x03(\v2\x16.service.ItemShortfallR\n" +
+	"shortfalls\x12.\n" +
+	"\x13next_available_time\x18\a \x01(\x03R\x11nextAvailableTime\x12\x1d\n" +
+	"\n" +
+	"uses_today\x18\b \x01(\x05R\tusesToday\x12'\n" +
+	"\x10max_uses_per_day\x18\t \x01(\x05R\rmaxUsesPerDay\"\x8d\x04\n" +
 	"\vEnergyState\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12%\n" +
 	"\x0ecurrent_energy\x18\x02 \x01(\x05R\rcurrentEnergy\x12\x1d\n" +
@@ -1449,58 +5435,283 @@ const file_service_proto_rawDesc = "" +
 	"\x12regen_rate_seconds\x18\x05 \x01(\x05R\x10regenRateSeconds\x12&\n" +
 	"\x0fnext_regen_time\x18\x06 \x01(\x03R\rnextRegenTime\x12\"\n" +
 	"\renergy_to_max\x18\a \x01(\x05R\venergyToMax\x12-\n" +
-	"\x13time_to_max_seconds\x18\b \x01(\x03R\x10timeToMaxSeconds\"\x8a\x01\n" +
+	"\x13time_to_max_seconds\x18\b \x01(\x03R\x10timeToMaxSeconds\x12\x17\n" +
+	"\apool_id\x18\t \x01(\tR\x06poolId\x12'\n" +
+	"\x0foverflow_energy\x18\n" +
+	" \x01(\x05R\x0eoverflowEnergy\x128\n" +
+	"\x18regen_multiplier_percent\x18\v \x01(\x05R\x16regenMultiplierPercent\x12+\n" +
+	"\x12next_regen_time_ms\x18\f \x01(\x03R\x0fnextRegenTimeMs\x12#\n" +
+	"\x0etime_to_max_ms\x18\r \x01(\x03R\vtimeToMaxMs\"\xf4\x01\n" +
+	"\n" +
+	"EnergyHold\x12\x17\n" +
+	"\ahold_id\x18\x01 \x01(\tR\x06holdId\x12\x1f\n" +
+	"\vaction_type\x18\x02 \x01(\tR\n" +
+	"actionType\x124\n" +
+	"\x05costs\x18\x03 \x03(\v2\x1e.service.EnergyHold.CostsEntryR\x05costs\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\x03R\texpiresAt\x1a8\n" +
+	"\n" +
+	"CostsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xa7\x03\n" +
+	"\vLedgerEntry\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\tR\aentryId\x12\x1c\n" +
+	"\ttimestamp\x18\x02 \x01(\x03R\ttimestamp\x12!\n" +
+	"\ftimestamp_ms\x18\x03 \x01(\x03R\vtimestampMs\x12\x1c\n" +
+	"\toperation\x18\x04 \x01(\tR\toperation\x12\x14\n" +
+	"\x05actor\x18\x05 \x01(\tR\x05actor\x12\x19\n" +
+	"\bactor_id\x18\x06 \x01(\tR\aactorId\x12\x1f\n" +
+	"\vaction_type\x18\a \x01(\tR\n" +
+	"actionType\x12\x1b\n" +
+	"\taction_id\x18\b \x01(\tR\bactionId\x12\x16\n" +
+	"\x06source\x18\t \x01(\tR\x06source\x12%\n" +
+	"\x0etransaction_id\x18\n" +
+	" \x01(\tR\rtransactionId\x12/\n" +
+	"\x05pools\x18\v \x03(\v2\x19.service.LedgerPoolChangeR\x05pools\x12'\n" +
+	"\x05items\x18\f \x03(\v2\x11.service.LootItemR\x05items\x12\x16\n" +
+	"\x06reason\x18\r \x01(\tR\x06reason\"\x8d\x01\n" +
+	"\x10LedgerPoolChange\x12\x17\n" +
+	"\apool_id\x18\x01 \x01(\tR\x06poolId\x12\x14\n" +
+	"\x05delta\x18\x02 \x01(\x05R\x05delta\x12%\n" +
+	"\x0ebalance_before\x18\x03 \x01(\x05R\rbalanceBefore\x12#\n" +
+	"\rbalance_after\x18\x04 \x01(\x05R\fbalanceAfter\"\xda\x01\n" +
+	"\n" +
+	"RegenBoost\x12\x19\n" +
+	"\bboost_id\x18\x01 \x01(\tR\aboostId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12-\n" +
+	"\x12multiplier_percent\x18\x03 \x01(\x05R\x11multiplierPercent\x12\x17\n" +
+	"\apool_id\x18\x04 \x01(\tR\x06poolId\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\x03R\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x06 \x01(\x03R\aendTime\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\"\xf2\x01\n" +
 	"\fEnergyConfig\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
 	"\n" +
 	"max_energy\x18\x02 \x01(\x05R\tmaxEnergy\x12,\n" +
 	"\x12regen_rate_seconds\x18\x03 \x01(\x05R\x10regenRateSeconds\x12\x14\n" +
-	"\x05level\x18\x04 \x01(\x05R\x05level2\xe5\x1a\n" +
-	"\aService\x12\xae\x02\n" +
-	"\vGetMyEnergy\x12\x1b.service.GetMyEnergyRequest\x1a\x1a.service.GetEnergyResponse\"\xe5\x01\x92A|\x12\rGet my energy\x1a]Get your current energy state. Automatically calculates regenerated energy since last update.b\f\n" +
+	"\x05level\x18\x04 \x01(\x05R\x05level\x122\n" +
+	"\x15max_energy_overridden\x18\x05 \x01(\bR\x13maxEnergyOverridden\x122\n" +
+	"\x15regen_rate_overridden\x18\x06 \x01(\bR\x13regenRateOverridden\"\xab\x01\n" +
+	"\x15NamespaceEnergyConfig\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1d\n" +
+	"\n" +
+	"max_energy\x18\x02 \x01(\x05R\tmaxEnergy\x12,\n" +
+	"\x12regen_rate_seconds\x18\x03 \x01(\x05R\x10regenRateSeconds\x12'\n" +
+	"\x0fstarting_energy\x18\x04 \x01(\x05R\x0estartingEnergy\"\xf4\x02\n" +
+	"\vCatalogItem\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12Q\n" +
+	"\x0flocalized_names\x18\x03 \x03(\v2(.service.CatalogItem.LocalizedNamesEntryR\x0elocalizedNames\x12\x16\n" +
+	"\x06rarity\x18\x04 \x01(\tR\x06rarity\x12\x1a\n" +
+	"\bcategory\x18\x05 \x01(\tR\bcategory\x12\x19\n" +
+	"\bicon_key\x18\x06 \x01(\tR\aiconKey\x12\x1b\n" +
+	"\tmax_stack\x18\a \x01(\x05R\bmaxStack\x12\x1a\n" +
+	"\btradable\x18\b \x01(\bR\btradable\x12\x1a\n" +
+	"\bsellable\x18\t \x01(\bR\bsellable\x1aA\n" +
+	"\x13LocalizedNamesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd3\x03\n" +
+	"\x06Recipe\x12\x1b\n" +
+	"\trecipe_id\x18\x01 \x01(\tR\brecipeId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12)\n" +
+	"\x06inputs\x18\x03 \x03(\v2\x11.service.LootItemR\x06inputs\x12+\n" +
+	"\aoutputs\x18\x04 \x03(\v2\x11.service.LootItemR\aoutputs\x12C\n" +
+	"\fenergy_costs\x18\x05 \x03(\v2 .service.Recipe.EnergyCostsEntryR\venergyCosts\x12'\n" +
+	"\x0fsuccess_percent\x18\x06 \x01(\x05R\x0esuccessPercent\x12!\n" +
+	"\funlock_level\x18\a \x01(\x05R\vunlockLevel\x12\x1a\n" +
+	"\bunlocked\x18\b \x01(\bR\bunlocked\x12\x1b\n" +
+	"\tcan_craft\x18\t \x01(\bR\bcanCraft\x126\n" +
+	"\n" +
+	"shortfalls\x18\n" +
+	" \x03(\v2\x16.service.ItemShortfallR\n" +
+	"shortfalls\x1a>\n" +
+	"\x10EnergyCostsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01*0\n" +
+	"\tBatchMode\x12\x12\n" +
+	"\x0eALL_OR_NOTHING\x10\x00\x12\x0f\n" +
+	"\vBEST_EFFORT\x10\x012\xd5~\n" +
+	"\aService\x12\xc3\x02\n" +
+	"\vGetMyEnergy\x12\x1b.service.GetMyEnergyRequest\x1a\x1a.service.GetEnergyResponse\"\xfa\x01\x92A|\x12\rGet my energy\x1a]Get your current energy state. Automatically calculates regenerated energy since last update.b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x184NAMESPACE:{namespace}:USER:{userId}:CLOUDSAVE:RECORD\x90\xb5\x18\x02\x82\xd3\xe4\x93\x029\x127/v1/public/namespace/{namespace}/users/{user_id}/energy\x12\xa0\x04\n" +
+	"\x0fConsumeMyEnergy\x12\x1f.service.ConsumeMyEnergyRequest\x1a\x1e.service.ConsumeEnergyResponse\"\xcb\x03\x92A\xc8\x02\x12\x11Consume my energy\x1a\x9d\x01Deduct energy for performing an in-game action. The action type determines the cost and which energy pools are charged. Returns error if insufficient energy.b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00r\x84\x01\n" +
+	"\x81\x01\n" +
+	"\x0fIdempotency-Key\x12lOptional. A repeated request with the same key returns the original response instead of being applied again.\x18\x01\x8a\xb5\x184NAMESPACE:{namespace}:USER:{userId}:CLOUDSAVE:RECORD\x90\xb5\x18\x04\x82\xd3\xe4\x93\x02=:\x01*\"8/v1/public/namespace/{namespace}/users/{user_id}/consume\x12\xa7\x05\n" +
+	"\x14ConsumeMyEnergyBatch\x12$.service.ConsumeMyEnergyBatchRequest\x1a#.service.ConsumeEnergyBatchResponse\"\xc3\x04\x92A\xba\x03\x12(Consume my energy for a batch of actions\x1a\xf8\x01Perform a sequence of actions (e.g. explore x5 auto-play) in one request, with a loot roll per action. ALL_OR_NOTHING performs every action or none if the total cost is not affordable, BEST_EFFORT performs as many as energy allows in request order.b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00r\x84\x01\n" +
+	"\x81\x01\n" +
+	"\x0fIdempotency-Key\x12lOptional. A repeated request with the same key returns the original response instead of being applied again.\x18\x01\x8a\xb5\x184NAMESPACE:{namespace}:USER:{userId}:CLOUDSAVE:RECORD\x90\xb5\x18\x04\x82\xd3\xe4\x93\x02C:\x01*\">/v1/public/namespace/{namespace}/users/{user_id}/consume/batch\x12\x93\x04\n" +
+	"\x0eRefillMyEnergy\x12\x1e.service.RefillMyEnergyRequest\x1a\x1d.service.RefillEnergyResponse\"\xc1\x03\x92A\xbf\x02\x12\x10Refill my energy\x1a\x95\x01Add energy to your pool. Used for purchases, rewards, level ups, and daily bonuses. Energy is capped at max energy unless the source allows overflow.b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00r\x84\x01\n" +
+	"\x81\x01\n" +
+	"\x0fIdempotency-Key\x12lOptional. A repeated request with the same key returns the original response instead of being applied again.\x18\x01\x8a\xb5\x184NAMESPACE:{namespace}:USER:{userId}:CLOUDSAVE:RECORD\x90\xb5\x18\x04\x82\xd3\xe4\x93\x02<:\x01*\"7/v1/public/namespace/{namespace}/users/{user_id}/refill\x12\xa3\x02\n" +
+	"\x0eGetMyInventory\x12\x1e.service.GetMyInventoryRequest\x1a\x1d.service.GetInventoryResponse\"\xd1\x01\x92AP\x12\x10Get my inventory\x1a.Get your current inventory of collected items.b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x184NAMESPACE:{namespace}:USER:{userId}:CLOUDSAVE:RECORD\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02<\x12:/v1/public/namespace/{namespace}/users/{user_id}/inventory\x12\x9e\x04\n" +
+	"\fSpendMyItems\x12\x1c.service.SpendMyItemsRequest\x1a .service.UpdateInventoryResponse\"\xcd\x03\x92A\xc2\x02\x12\x0eSpend my items\x1a\x9a\x01Remove items from your inventory, e.g. to pay gold or use a herb. Either every item is spent or, if any is short, none is and the shortfalls are returned.b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00r\x84\x01\n" +
+	"\x81\x01\n" +
+	"\x0fIdempotency-Key\x12lOptional. A repeated request with the same key returns the original response instead of being applied again.\x18\x01\x8a\xb5\x184NAMESPACE:{namespace}:USER:{userId}:CLOUDSAVE:RECORD\x90\xb5\x18\x04\x82\xd3\xe4\x93\x02E:\x01*\"@/v1/public/namespace/{namespace}/users/{user_id}/inventory/spend\x12\x8a\x04\n" +
+	"\x13ClaimMyPendingItems\x12#.service.ClaimMyPendingItemsRequest\x1a .service.UpdateInventoryResponse\"\xab\x03\x92A\xa0\x02\x12\x16Claim my pending items\x1aqMove loot that did not fit in your inventory into it, as much as fits now. What still does not fit stays pending.b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00r\x84\x01\n" +
+	"\x81\x01\n" +
+	"\x0fIdempotency-Key\x12lOptional. A repeated request with the same key returns the original response instead of being applied again.\x18\x01\x8a\xb5\x184NAMESPACE:{namespace}:USER:{userId}:CLOUDSAVE:RECORD\x90\xb5\x18\x04\x82\xd3\xe4\x93\x02E:\x01*\"@/v1/public/namespace/{namespace}/users/{user_id}/inventory/claim\x12\xb2\x02\n" +
+	"\x11GetMyEnergyConfig\x12!.service.GetMyEnergyConfigRequest\x1a .service.GetEnergyConfigResponse\"\xd7\x01\x92AY\x12\x14Get my energy config\x1a3Get your max energy and regeneration rate settings.b\f\n" +
+	"\n" +
 	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x184NAMESPACE:{namespace}:USER:{userId}:CLOUDSAVE:RECORD\x90\xb5\x18\x02\x82\xd3\xe4\x93\x029\x127/v1/public/namespace/{namespace}/users/{user_id}/config\x12\x9b\x04\n" +
+	"\x0fLevelUpMyEnergy\x12\x1f.service.LevelUpMyEnergyRequest\x1a\x1f.service.SetEnergyLevelResponse\"\xc5\x03\x92A\xc2\x02\x12\x12Level up my energy\x1a\x96\x01Spend the items required for the next energy level to raise your max energy and regeneration rate. Returns success false if you do not have the items.b\f\n" +
 	"\n" +
-	"\x06Bearer\x12\x00\x8a\xb5\x18&NAMESPACE:{namespace}:CLOUDSAVE:RECORD\x90\xb5\x18\x02\x82\xd3\xe4\x93\x022\x120/v1/public/namespace/{namespace}/users/me/energy\x12\xba\x02\n" +
-	"\x0fConsumeMyEnergy\x12\x1f.service.ConsumeMyEnergyRequest\x1a\x1e.service.ConsumeEnergyResponse\"\xe5\x01\x92Ax\x12\x11Consume my energy\x1aUDeduct energy for performing an in-game action. Returns error if insufficient energy.b\f\n" +
 	"\n" +
+	"\x06Bearer\x12\x00r\x84\x01\n" +
+	"\x81\x01\n" +
+	"\x0fIdempotency-Key\x12lOptional. A repeated request with the same key returns the original response instead of being applied again.\x18\x01\x8a\xb5\x184NAMESPACE:{namespace}:USER:{userId}:CLOUDSAVE:RECORD\x90\xb5\x18\x04\x82\xd3\xe4\x93\x02=:\x01*\"8/v1/public/namespace/{namespace}/users/{user_id}/levelup\x12\xab\x05\n" +
+	"\rReserveEnergy\x12\x1d.service.ReserveEnergyRequest\x1a\x1e.service.ReserveEnergyResponse\"\xda\x04\x92A\xd9\x03\x12\x0eReserve energy\x1a\xb1\x02Deduct the cost of an action into a hold, e.g. when a battle starts. Commit the hold when the action resolves to receive its loot, or release it to get the energy back. A hold that is neither committed nor released before it expires is refunded automatically. Returns success false if insufficient energy.b\f\n" +
 	"\n" +
-	"\x06Bearer\x12\x00\x8a\xb5\x18&NAMESPACE:{namespace}:CLOUDSAVE:RECORD\x90\xb5\x18\x04\x82\xd3\xe4\x93\x026:\x01*\"1/v1/public/namespace/{namespace}/users/me/consume\x12\xb3\x02\n" +
-	"\x0eRefillMyEnergy\x12\x1e.service.RefillMyEnergyRequest\x1a\x1d.service.RefillEnergyResponse\"\xe1\x01\x92Au\x12\x10Refill my energy\x1aSAdd energy to your pool. Used for purchases, rewards, level ups, and daily bonuses.b\f\n" +
 	"\n" +
+	"\x06Bearer\x12\x00r\x84\x01\n" +
+	"\x81\x01\n" +
+	"\x0fIdempotency-Key\x12lOptional. A repeated request with the same key returns the original response instead of being applied again.\x18\x01\x8a\xb5\x184NAMESPACE:{namespace}:USER:{userId}:CLOUDSAVE:RECORD\x90\xb5\x18\x04\x82\xd3\xe4\x93\x02;:\x01*\"6/v1/public/namespace/{namespace}/users/{user_id}/holds\x12\x9d\x04\n" +
+	"\fCommitEnergy\x12\x1c.service.CommitEnergyRequest\x1a\x1d.service.CommitEnergyResponse\"\xcf\x03\x92A\xbd\x02\x12\x12Commit energy hold\x1a\x91\x01Finalize a hold when its action resolves. The held energy stays spent and loot is rolled from the action's loot table. Fails if the hold expired.b\f\n" +
 	"\n" +
-	"\x06Bearer\x12\x00\x8a\xb5\x18&NAMESPACE:{namespace}:CLOUDSAVE:RECORD\x90\xb5\x18\x04\x82\xd3\xe4\x93\x025:\x01*\"0/v1/public/namespace/{namespace}/users/me/refill\x12\x8e\x02\n" +
-	"\x0eGetMyInventory\x12\x1e.service.GetMyInventoryRequest\x1a\x1d.service.GetInventoryResponse\"\xbc\x01\x92AP\x12\x10Get my inventory\x1a.Get your current inventory of collected items.b\f\n" +
 	"\n" +
+	"\x06Bearer\x12\x00r\x84\x01\n" +
+	"\x81\x01\n" +
+	"\x0fIdempotency-Key\x12lOptional. A repeated request with the same key returns the original response instead of being applied again.\x18\x01\x8a\xb5\x184NAMESPACE:{namespace}:USER:{userId}:CLOUDSAVE:RECORD\x90\xb5\x18\x04\x82\xd3\xe4\x93\x02L:\x01*\"G/v1/public/namespace/{namespace}/users/{user_id}/holds/{hold_id}/commit\x12\x87\x04\n" +
+	"\rReleaseEnergy\x12\x1d.service.ReleaseEnergyRequest\x1a\x1e.service.ReleaseEnergyResponse\"\xb6\x03\x92A\xa3\x02\x12\x13Release energy hold\x1awCancel a hold, e.g. when a battle is abandoned, and refund its energy. Refunds never raise a pool above its max energy.b\f\n" +
 	"\n" +
-	"\x06Bearer\x12\x00\x8a\xb5\x18&NAMESPACE:{namespace}:CLOUDSAVE:RECORD\x90\xb5\x18\x02\x82\xd3\xe4\x93\x025\x123/v1/public/namespace/{namespace}/users/me/inventory\x12\x9d\x02\n" +
-	"\x11GetMyEnergyConfig\x12!.service.GetMyEnergyConfigRequest\x1a .service.GetEnergyConfigResponse\"\xc2\x01\x92AY\x12\x14Get my energy config\x1a3Get your max energy and regeneration rate settings.b\f\n" +
 	"\n" +
+	"\x06Bearer\x12\x00r\x84\x01\n" +
+	"\x81\x01\n" +
+	"\x0fIdempotency-Key\x12lOptional. A repeated request with the same key returns the original response instead of being applied again.\x18\x01\x8a\xb5\x184NAMESPACE:{namespace}:USER:{userId}:CLOUDSAVE:RECORD\x90\xb5\x18\x04\x82\xd3\xe4\x93\x02M:\x01*\"H/v1/public/namespace/{namespace}/users/{user_id}/holds/{hold_id}/release\x12\x80\x03\n" +
+	"\x13ListMyEnergyHistory\x12#.service.ListMyEnergyHistoryRequest\x1a\".service.ListEnergyHistoryResponse\"\x9f\x02\x92A\x9f\x01\x12\x16List my energy history\x1awList the changes of your energy and inventory, newest first. Pass next_cursor of a page as cursor to get the next page.b\f\n" +
 	"\n" +
-	"\x06Bearer\x12\x00\x8a\xb5\x18&NAMESPACE:{namespace}:CLOUDSAVE:RECORD\x90\xb5\x18\x02\x82\xd3\xe4\x93\x022\x120/v1/public/namespace/{namespace}/users/me/config\x12\xd0\x02\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x184NAMESPACE:{namespace}:USER:{userId}:CLOUDSAVE:RECORD\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02:\x128/v1/public/namespace/{namespace}/users/{user_id}/history\x12\x8f\x02\n" +
+	"\x0fListItemCatalog\x12\x1f.service.ListItemCatalogRequest\x1a .service.ListItemCatalogResponse\"\xb8\x01\x92A\x86\x01\x12\x15List the item catalog\x1a_List every item players can own with its display names, rarity, category, icon and stack limit.b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x82\xd3\xe4\x93\x02(\x12&/v1/public/namespace/{namespace}/items\x12\xca\x04\n" +
+	"\tCraftItem\x12\x19.service.CraftItemRequest\x1a\x1a.service.CraftItemResponse\"\x85\x04\x92A\x84\x03\x12\rCraft an item\x1a\xdd\x01Spend the inputs and energy of a recipe to craft its outputs. A recipe with a success chance may fail, still spending its inputs and energy. Nothing is spent if the recipe is locked or you are short of an input or energy.b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00r\x84\x01\n" +
+	"\x81\x01\n" +
+	"\x0fIdempotency-Key\x12lOptional. A repeated request with the same key returns the original response instead of being applied again.\x18\x01\x8a\xb5\x184NAMESPACE:{namespace}:USER:{userId}:CLOUDSAVE:RECORD\x90\xb5\x18\x04\x82\xd3\xe4\x93\x02;:\x01*\"6/v1/public/namespace/{namespace}/users/{user_id}/craft\x12\xc1\x02\n" +
+	"\vListRecipes\x12\x1b.service.ListRecipesRequest\x1a\x1c.service.ListRecipesResponse\"\xf6\x01\x92Aw\x12\fList recipes\x1aYList every crafting recipe with whether you have unlocked it and can currently afford it.b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x184NAMESPACE:{namespace}:USER:{userId}:CLOUDSAVE:RECORD\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02:\x128/v1/public/namespace/{namespace}/users/{user_id}/recipes\x12\xec\x04\n" +
+	"\x11ExchangeForEnergy\x12!.service.ExchangeForEnergyRequest\x1a\".service.ExchangeForEnergyResponse\"\x8f\x04\x92A\x8b\x03\x12\x19Exchange items for energy\x1a\xd8\x01Spend the price of an exchange offer, e.g. gold or gems, to gain its energy. Energy is capped at max energy. Nothing is spent if you are short of an item, the energy is already full or the offer is not available yet.b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00r\x84\x01\n" +
+	"\x81\x01\n" +
+	"\x0fIdempotency-Key\x12lOptional. A repeated request with the same key returns the original response instead of being applied again.\x18\x01\x8a\xb5\x184NAMESPACE:{namespace}:USER:{userId}:CLOUDSAVE:RECORD\x90\xb5\x18\x04\x82\xd3\xe4\x93\x02>:\x01*\"9/v1/public/namespace/{namespace}/users/{user_id}/exchange\x12\xd0\x02\n" +
 	"\tGetEnergy\x12\x19.service.GetEnergyRequest\x1a\x1a.service.GetEnergyResponse\"\x8b\x02\x92A\x94\x01\x12\x19[Admin] Get player energy\x1aiGet the current energy state for a player. Automatically calculates regenerated energy since last update.b\f\n" +
 	"\n" +
 	"\n" +
-	"\x06Bearer\x12\x00\x8a\xb5\x18,ADMIN:NAMESPACE:{namespace}:CLOUDSAVE:RECORD\x90\xb5\x18\x02\x82\xd3\xe4\x93\x029\x127/v1/admin/namespace/{namespace}/player/{user_id}/energy\x12\xbb\x02\n" +
-	"\rConsumeEnergy\x12\x1d.service.ConsumeEnergyRequest\x1a\x1e.service.ConsumeEnergyResponse\"\xea\x01\x92Ap\x12\x1d[Admin] Consume player energy\x1aADeduct energy for a player. Returns error if insufficient energy.b\f\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18,ADMIN:NAMESPACE:{namespace}:CLOUDSAVE:RECORD\x90\xb5\x18\x02\x82\xd3\xe4\x93\x029\x127/v1/admin/namespace/{namespace}/player/{user_id}/energy\x12\xc3\x03\n" +
+	"\rConsumeEnergy\x12\x1d.service.ConsumeEnergyRequest\x1a\x1e.service.ConsumeEnergyResponse\"\xf2\x02\x92A\xf7\x01\x12\x1d[Admin] Consume player energy\x1aADeduct energy for a player. Returns error if insufficient energy.b\f\n" +
 	"\n" +
 	"\n" +
-	"\x06Bearer\x12\x00\x8a\xb5\x18,ADMIN:NAMESPACE:{namespace}:CLOUDSAVE:RECORD\x90\xb5\x18\x04\x82\xd3\xe4\x93\x02=:\x01*\"8/v1/admin/namespace/{namespace}/player/{user_id}/consume\x12\xcc\x02\n" +
-	"\fRefillEnergy\x12\x1c.service.RefillEnergyRequest\x1a\x1d.service.RefillEnergyResponse\"\xfe\x01\x92A\x84\x01\x12\x1c[Admin] Refill player energy\x1aVAdd energy to a player's pool. Used for admin grants, corrections, or backend rewards.b\f\n" +
+	"\x06Bearer\x12\x00r\x84\x01\n" +
+	"\x81\x01\n" +
+	"\x0fIdempotency-Key\x12lOptional. A repeated request with the same key returns the original response instead of being applied again.\x18\x01\x8a\xb5\x18,ADMIN:NAMESPACE:{namespace}:CLOUDSAVE:RECORD\x90\xb5\x18\x04\x82\xd3\xe4\x93\x02=:\x01*\"8/v1/admin/namespace/{namespace}/player/{user_id}/consume\x12\x96\x04\n" +
+	"\fRefillEnergy\x12\x1c.service.RefillEnergyRequest\x1a\x1d.service.RefillEnergyResponse\"\xc8\x03\x92A\xce\x02\x12\x1c[Admin] Refill player energy\x1a\x98\x01Add energy to a player's pool. Used for admin grants, corrections, or backend rewards. Energy is capped at max energy unless the source allows overflow.b\f\n" +
 	"\n" +
 	"\n" +
-	"\x06Bearer\x12\x00\x8a\xb5\x18,ADMIN:NAMESPACE:{namespace}:CLOUDSAVE:RECORD\x90\xb5\x18\x04\x82\xd3\xe4\x93\x02<:\x01*\"7/v1/admin/namespace/{namespace}/player/{user_id}/refill\x12\xba\x02\n" +
+	"\x06Bearer\x12\x00r\x84\x01\n" +
+	"\x81\x01\n" +
+	"\x0fIdempotency-Key\x12lOptional. A repeated request with the same key returns the original response instead of being applied again.\x18\x01\x8a\xb5\x18,ADMIN:NAMESPACE:{namespace}:CLOUDSAVE:RECORD\x90\xb5\x18\x04\x82\xd3\xe4\x93\x02<:\x01*\"7/v1/admin/namespace/{namespace}/player/{user_id}/refill\x12\xba\x02\n" +
 	"\x0fGetEnergyConfig\x12\x1f.service.GetEnergyConfigRequest\x1a .service.GetEnergyConfigResponse\"\xe3\x01\x92Am\x12 [Admin] Get player energy config\x1a;Get the player's max energy and regeneration rate settings.b\f\n" +
 	"\n" +
 	"\n" +
-	"\x06Bearer\x12\x00\x8a\xb5\x18,ADMIN:NAMESPACE:{namespace}:CLOUDSAVE:RECORD\x90\xb5\x18\x02\x82\xd3\xe4\x93\x029\x127/v1/admin/namespace/{namespace}/player/{user_id}/config\x12\xbb\x02\n" +
-	"\x12UpdateEnergyConfig\x12\".service.UpdateEnergyConfigRequest\x1a#.service.UpdateEnergyConfigResponse\"\xdb\x01\x92Ab\x12#[Admin] Update player energy config\x1a-Update max energy or regen rate for a player.b\f\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18,ADMIN:NAMESPACE:{namespace}:CLOUDSAVE:RECORD\x90\xb5\x18\x02\x82\xd3\xe4\x93\x029\x127/v1/admin/namespace/{namespace}/player/{user_id}/config\x12\x8d\x04\n" +
+	"\x12UpdateEnergyConfig\x12\".service.UpdateEnergyConfigRequest\x1a#.service.UpdateEnergyConfigResponse\"\xad\x03\x92A\xb3\x02\x12#[Admin] Update player energy config\x1awOverride max energy or regen rate for a player. Overrides take precedence over the player's energy level until cleared.b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00r\x84\x01\n" +
+	"\x81\x01\n" +
+	"\x0fIdempotency-Key\x12lOptional. A repeated request with the same key returns the original response instead of being applied again.\x18\x01\x8a\xb5\x18,ADMIN:NAMESPACE:{namespace}:CLOUDSAVE:RECORD\x90\xb5\x18\x04\x82\xd3\xe4\x93\x02<:\x01*\x1a7/v1/admin/namespace/{namespace}/player/{user_id}/config\x12\xf7\x03\n" +
+	"\x0eSetEnergyLevel\x12\x1e.service.SetEnergyLevelRequest\x1a\x1f.service.SetEnergyLevelResponse\"\xa3\x03\x92A\xaa\x02\x12\x1f[Admin] Set player energy level\x1arSet a player's energy level without spending items. The level's max energy and regen rate apply unless overridden.b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00r\x84\x01\n" +
+	"\x81\x01\n" +
+	"\x0fIdempotency-Key\x12lOptional. A repeated request with the same key returns the original response instead of being applied again.\x18\x01\x8a\xb5\x18,ADMIN:NAMESPACE:{namespace}:CLOUDSAVE:RECORD\x90\xb5\x18\x04\x82\xd3\xe4\x93\x02;:\x01*\x1a6/v1/admin/namespace/{namespace}/player/{user_id}/level\x12\xbe\x04\n" +
+	"\vResetEnergy\x12\x1b.service.ResetEnergyRequest\x1a\x1c.service.ResetEnergyResponse\"\xf3\x03\x92A\xfa\x02\x12\x1b[Admin] Reset player energy\x1a\xc5\x01Reset a player's energy to the namespace defaults and refund their open holds. The inventory is kept unless wipe_inventory is set, refill cooldowns and daily limits are always kept. Admin use only.b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00r\x84\x01\n" +
+	"\x81\x01\n" +
+	"\x0fIdempotency-Key\x12lOptional. A repeated request with the same key returns the original response instead of being applied again.\x18\x01\x8a\xb5\x18,ADMIN:NAMESPACE:{namespace}:CLOUDSAVE:RECORD\x90\xb5\x18\b\x82\xd3\xe4\x93\x02;:\x01*\"6/v1/admin/namespace/{namespace}/player/{user_id}/reset\x12\xd5\x03\n" +
+	"\n" +
+	"GrantItems\x12\x1a.service.GrantItemsRequest\x1a .service.UpdateInventoryResponse\"\x88\x03\x92A\x85\x02\x12\x13[Admin] Grant items\x1aYAdd catalog items to a player's inventory. Used for compensation, rewards or corrections.b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00r\x84\x01\n" +
+	"\x81\x01\n" +
+	"\x0fIdempotency-Key\x12lOptional. A repeated request with the same key returns the original response instead of being applied again.\x18\x01\x8a\xb5\x18,ADMIN:NAMESPACE:{namespace}:CLOUDSAVE:RECORD\x90\xb5\x18\x04\x82\xd3\xe4\x93\x02E:\x01*\"@/v1/admin/namespace/{namespace}/player/{user_id}/inventory/grant\x12\x96\x04\n" +
+	"\vRevokeItems\x12\x1b.service.RevokeItemsRequest\x1a .service.UpdateInventoryResponse\"\xc7\x03\x92A\xc3\x02\x12\x14[Admin] Revoke items\x1a\x95\x01Remove items from a player's inventory. Either every item is removed or, if the player holds too few of any, none is and the shortfalls are returned.b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00r\x84\x01\n" +
+	"\x81\x01\n" +
+	"\x0fIdempotency-Key\x12lOptional. A repeated request with the same key returns the original response instead of being applied again.\x18\x01\x8a\xb5\x18,ADMIN:NAMESPACE:{namespace}:CLOUDSAVE:RECORD\x90\xb5\x18\x04\x82\xd3\xe4\x93\x02F:\x01*\"A/v1/admin/namespace/{namespace}/player/{user_id}/inventory/revoke\x12\xb5\x03\n" +
+	"\x16ListEnergyTransactions\x12&.service.ListEnergyTransactionsRequest\x1a\".service.ListEnergyHistoryResponse\"\xce\x02\x92A\xd1\x01\x12'[Admin] List player energy transactions\x1a\x97\x01List every change of a player's energy and inventory with who made it and why, newest first. Pass next_cursor of a page as cursor to get the next page.b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18,ADMIN:NAMESPACE:{namespace}:CLOUDSAVE:RECORD\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02?\x12=/v1/admin/namespace/{namespace}/player/{user_id}/transactions\x12\xa7\x03\n" +
+	"\x18GetNamespaceEnergyConfig\x12(.service.GetNamespaceEnergyConfigRequest\x1a).service.GetNamespaceEnergyConfigResponse\"\xb5\x02\x92A\xcf\x01\x12#[Admin] Get namespace energy config\x1a\x99\x01Get the max energy, regeneration rate and starting energy new players in the namespace get. Returns the built-in defaults if the namespace has no config.b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18,ADMIN:NAMESPACE:{namespace}:CLOUDSAVE:RECORD\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02(\x12&/v1/admin/namespace/{namespace}/config\x12\x94\x03\n" +
+	"\x1bUpdateNamespaceEnergyConfig\x12+.service.UpdateNamespaceEnergyConfigRequest\x1a,.service.UpdateNamespaceEnergyConfigResponse\"\x99\x02\x92A\xb0\x01\x12&[Admin] Update namespace energy config\x1axUpdate the defaults for new players in the namespace. Existing players keep their current settings until they are reset.b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18,ADMIN:NAMESPACE:{namespace}:CLOUDSAVE:RECORD\x90\xb5\x18\x04\x82\xd3\xe4\x93\x02+:\x01*\x1a&/v1/admin/namespace/{namespace}/config\x12\xd2\x04\n" +
+	"\x10CreateRegenBoost\x12 .service.CreateRegenBoostRequest\x1a\x1b.service.RegenBoostResponse\"\xfe\x03\x92A\xd7\x02\x12\x1a[Admin] Create regen boost\x1a\xa3\x01Multiply regeneration speed during a time window, for one player or, without a player, for the whole namespace (e.g. a 2x happy hour). Overlapping boosts multiply.b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00r\x84\x01\n" +
+	"\x81\x01\n" +
+	"\x0fIdempotency-Key\x12lOptional. A repeated request with the same key returns the original response instead of being applied again.\x18\x01\x8a\xb5\x18,ADMIN:NAMESPACE:{namespace}:CLOUDSAVE:RECORD\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02i:\x01*Z+:\x01*\"&/v1/admin/namespace/{namespace}/boosts\"7/v1/admin/namespace/{namespace}/player/{user_id}/boosts\x12\x92\x03\n" +
+	"\x0fListRegenBoosts\x12\x1f.service.ListRegenBoostsRequest\x1a .service.ListRegenBoostsResponse\"\xbb\x02\x92A\x9a\x01\x12\x19[Admin] List regen boosts\x1aoList active and upcoming regen boosts. For a player this includes the namespace-wide events that apply to them.b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18,ADMIN:NAMESPACE:{namespace}:CLOUDSAVE:RECORD\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02cZ(\x12&/v1/admin/namespace/{namespace}/boosts\x127/v1/admin/namespace/{namespace}/player/{user_id}/boosts\x12\xa8\x04\n" +
+	"\x10CancelRegenBoost\x12 .service.CancelRegenBoostRequest\x1a\x1b.service.RegenBoostResponse\"\xd4\x03\x92A\x9d\x02\x12\x1a[Admin] Cancel regen boost\x1ajEnd a regen boost now. Regeneration already earned during the boost is kept, an upcoming boost is removed.b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00r\x84\x01\n" +
+	"\x81\x01\n" +
+	"\x0fIdempotency-Key\x12lOptional. A repeated request with the same key returns the original response instead of being applied again.\x18\x01\x8a\xb5\x18,ADMIN:NAMESPACE:{namespace}:CLOUDSAVE:RECORD\x90\xb5\x18\b\x82\xd3\xe4\x93\x02yZ3*1/v1/admin/namespace/{namespace}/boosts/{boost_id}*B/v1/admin/namespace/{namespace}/player/{user_id}/boosts/{boost_id}\x12\xcb\x02\n" +
+	"\x12GetDebugTimeOffset\x12\".service.GetDebugTimeOffsetRequest\x1a .service.DebugTimeOffsetResponse\"\xee\x01\x92A~\x12\x1d[Admin] Get debug time offset\x1aOGet how far the service clock is moved ahead for every player in the namespace.b\f\n" +
 	"\n" +
 	"\n" +
-	"\x06Bearer\x12\x00\x8a\xb5\x18,ADMIN:NAMESPACE:{namespace}:CLOUDSAVE:RECORD\x90\xb5\x18\x04\x82\xd3\xe4\x93\x02<:\x01*\x1a7/v1/admin/namespace/{namespace}/player/{user_id}/config\x12\xa9\x02\n" +
-	"\vResetEnergy\x12\x1b.service.ResetEnergyRequest\x1a\x1c.service.ResetEnergyResponse\"\xde\x01\x92Af\x12\x1b[Admin] Reset player energy\x1a9Reset a player's energy to default state. Admin use only.b\f\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18,ADMIN:NAMESPACE:{namespace}:CLOUDSAVE:RECORD\x90\xb5\x18\x02\x82\xd3\xe4\x93\x023\x121/v1/admin/namespace/{namespace}/debug/time-offset\x12\x85\x04\n" +
+	"\x12SetDebugTimeOffset\x12\".service.SetDebugTimeOffsetRequest\x1a .service.DebugTimeOffsetResponse\"\xa8\x03\x92A\xb4\x02\x12\x1d[Admin] Set debug time offset\x1a\x84\x02Fast-forward regeneration for every player in a test namespace by moving its clock ahead, 0 goes back to real time. Lowering the offset does not undo regeneration already applied. Only available when the service runs with ENERGY_DEBUG_TIME_OFFSET_ENABLED=true.b\f\n" +
 	"\n" +
 	"\n" +
-	"\x06Bearer\x12\x00\x8a\xb5\x18,ADMIN:NAMESPACE:{namespace}:CLOUDSAVE:RECORD\x90\xb5\x18\b\x82\xd3\xe4\x93\x02;:\x01*\"6/v1/admin/namespace/{namespace}/player/{user_id}/resetB\xcb\x02\x92A\xdc\x01\x12\xa4\x01\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18,ADMIN:NAMESPACE:{namespace}:CLOUDSAVE:RECORD\x90\xb5\x18\x04\x82\xd3\xe4\x93\x026:\x01*\x1a1/v1/admin/namespace/{namespace}/debug/time-offsetB\xcb\x02\x92A\xdc\x01\x12\xa4\x01\n" +
 	"\x12Energy Service API\x12\x88\x01Manages player energy for action-based gameplay. Classic mobile game energy system with regeneration, consumption, and refill mechanics.2\x031.0\"\x12/energy-based-gameZ\x1f\n" +
 	"\x1d\n" +
 	"\x06Bearer\x12\x13\b\x02\x1a\rAuthorization \x02\n" +
@@ -1518,67 +5729,220 @@ func file_service_proto_rawDescGZIP() []byte {
 	return file_service_proto_rawDescData
 }
 
-var file_service_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_service_proto_msgTypes = make([]protoimpl.MessageInfo, 76)
 var file_service_proto_goTypes = []any{
-	(*GetMyEnergyRequest)(nil),         // 0: service.GetMyEnergyRequest
-	(*ConsumeMyEnergyRequest)(nil),     // 1: service.ConsumeMyEnergyRequest
-	(*RefillMyEnergyRequest)(nil),      // 2: service.RefillMyEnergyRequest
-	(*GetMyEnergyConfigRequest)(nil),   // 3: service.GetMyEnergyConfigRequest
-	(*GetMyInventoryRequest)(nil),      // 4: service.GetMyInventoryRequest
-	(*GetEnergyRequest)(nil),           // 5: service.GetEnergyRequest
-	(*ConsumeEnergyRequest)(nil),       // 6: service.ConsumeEnergyRequest
-	(*RefillEnergyRequest)(nil),        // 7: service.RefillEnergyRequest
-	(*GetEnergyConfigRequest)(nil),     // 8: service.GetEnergyConfigRequest
-	(*UpdateEnergyConfigRequest)(nil),  // 9: service.UpdateEnergyConfigRequest
-	(*ResetEnergyRequest)(nil),         // 10: service.ResetEnergyRequest
-	(*GetEnergyResponse)(nil),          // 11: service.GetEnergyResponse
-	(*ConsumeEnergyResponse)(nil),      // 12: service.ConsumeEnergyResponse
-	(*LootItem)(nil),                   // 13: service.LootItem
-	(*RefillEnergyResponse)(nil),       // 14: service.RefillEnergyResponse
-	(*GetEnergyConfigResponse)(nil),    // 15: service.GetEnergyConfigResponse
-	(*GetInventoryResponse)(nil),       // 16: service.GetInventoryResponse
-	(*InventoryItem)(nil),              // 17: service.InventoryItem
-	(*UpdateEnergyConfigResponse)(nil), // 18: service.UpdateEnergyConfigResponse
-	(*ResetEnergyResponse)(nil),        // 19: service.ResetEnergyResponse
-	(*EnergyState)(nil),                // 20: service.EnergyState
-	(*EnergyConfig)(nil),               // 21: service.EnergyConfig
+	(BatchMode)(0),                              // 0: service.BatchMode
+	(*GetMyEnergyRequest)(nil),                  // 1: service.GetMyEnergyRequest
+	(*ConsumeMyEnergyRequest)(nil),              // 2: service.ConsumeMyEnergyRequest
+	(*ConsumeMyEnergyBatchRequest)(nil),         // 3: service.ConsumeMyEnergyBatchRequest
+	(*BatchAction)(nil),                         // 4: service.BatchAction
+	(*SpendMyItemsRequest)(nil),                 // 5: service.SpendMyItemsRequest
+	(*ClaimMyPendingItemsRequest)(nil),          // 6: service.ClaimMyPendingItemsRequest
+	(*RefillMyEnergyRequest)(nil),               // 7: service.RefillMyEnergyRequest
+	(*GetMyEnergyConfigRequest)(nil),            // 8: service.GetMyEnergyConfigRequest
+	(*GetMyInventoryRequest)(nil),               // 9: service.GetMyInventoryRequest
+	(*LevelUpMyEnergyRequest)(nil),              // 10: service.LevelUpMyEnergyRequest
+	(*ReserveEnergyRequest)(nil),                // 11: service.ReserveEnergyRequest
+	(*CommitEnergyRequest)(nil),                 // 12: service.CommitEnergyRequest
+	(*ReleaseEnergyRequest)(nil),                // 13: service.ReleaseEnergyRequest
+	(*ListMyEnergyHistoryRequest)(nil),          // 14: service.ListMyEnergyHistoryRequest
+	(*ListItemCatalogRequest)(nil),              // 15: service.ListItemCatalogRequest
+	(*CraftItemRequest)(nil),                    // 16: service.CraftItemRequest
+	(*ListRecipesRequest)(nil),                  // 17: service.ListRecipesRequest
+	(*ExchangeForEnergyRequest)(nil),            // 18: service.ExchangeForEnergyRequest
+	(*GetEnergyRequest)(nil),                    // 19: service.GetEnergyRequest
+	(*ConsumeEnergyRequest)(nil),                // 20: service.ConsumeEnergyRequest
+	(*RefillEnergyRequest)(nil),                 // 21: service.RefillEnergyRequest
+	(*GetEnergyConfigRequest)(nil),              // 22: service.GetEnergyConfigRequest
+	(*UpdateEnergyConfigRequest)(nil),           // 23: service.UpdateEnergyConfigRequest
+	(*SetEnergyLevelRequest)(nil),               // 24: service.SetEnergyLevelRequest
+	(*ResetEnergyRequest)(nil),                  // 25: service.ResetEnergyRequest
+	(*GrantItemsRequest)(nil),                   // 26: service.GrantItemsRequest
+	(*RevokeItemsRequest)(nil),                  // 27: service.RevokeItemsRequest
+	(*ListEnergyTransactionsRequest)(nil),       // 28: service.ListEnergyTransactionsRequest
+	(*GetNamespaceEnergyConfigRequest)(nil),     // 29: service.GetNamespaceEnergyConfigRequest
+	(*CreateRegenBoostRequest)(nil),             // 30: service.CreateRegenBoostRequest
+	(*ListRegenBoostsRequest)(nil),              // 31: service.ListRegenBoostsRequest
+	(*CancelRegenBoostRequest)(nil),             // 32: service.CancelRegenBoostRequest
+	(*GetDebugTimeOffsetRequest)(nil),           // 33: service.GetDebugTimeOffsetRequest
+	(*SetDebugTimeOffsetRequest)(nil),           // 34: service.SetDebugTimeOffsetRequest
+	(*UpdateNamespaceEnergyConfigRequest)(nil),  // 35: service.UpdateNamespaceEnergyConfigRequest
+	(*GetEnergyResponse)(nil),                   // 36: service.GetEnergyResponse
+	(*ConsumeEnergyResponse)(nil),               // 37: service.ConsumeEnergyResponse
+	(*ReserveEnergyResponse)(nil),               // 38: service.ReserveEnergyResponse
+	(*CommitEnergyResponse)(nil),                // 39: service.CommitEnergyResponse
+	(*ReleaseEnergyResponse)(nil),               // 40: service.ReleaseEnergyResponse
+	(*ConsumeEnergyBatchResponse)(nil),          // 41: service.ConsumeEnergyBatchResponse
+	(*BatchActionResult)(nil),                   // 42: service.BatchActionResult
+	(*LootItem)(nil),                            // 43: service.LootItem
+	(*RefillEnergyResponse)(nil),                // 44: service.RefillEnergyResponse
+	(*GetEnergyConfigResponse)(nil),             // 45: service.GetEnergyConfigResponse
+	(*GetInventoryResponse)(nil),                // 46: service.GetInventoryResponse
+	(*InventoryItem)(nil),                       // 47: service.InventoryItem
+	(*ItemQuantity)(nil),                        // 48: service.ItemQuantity
+	(*ItemShortfall)(nil),                       // 49: service.ItemShortfall
+	(*UpdateEnergyConfigResponse)(nil),          // 50: service.UpdateEnergyConfigResponse
+	(*SetEnergyLevelResponse)(nil),              // 51: service.SetEnergyLevelResponse
+	(*ResetEnergyResponse)(nil),                 // 52: service.ResetEnergyResponse
+	(*UpdateInventoryResponse)(nil),             // 53: service.UpdateInventoryResponse
+	(*ListEnergyHistoryResponse)(nil),           // 54: service.ListEnergyHistoryResponse
+	(*GetNamespaceEnergyConfigResponse)(nil),    // 55: service.GetNamespaceEnergyConfigResponse
+	(*RegenBoostResponse)(nil),                  // 56: service.RegenBoostResponse
+	(*ListRegenBoostsResponse)(nil),             // 57: service.ListRegenBoostsResponse
+	(*DebugTimeOffsetResponse)(nil),             // 58: service.DebugTimeOffsetResponse
+	(*UpdateNamespaceEnergyConfigResponse)(nil), // 59: service.UpdateNamespaceEnergyConfigResponse
+	(*ListItemCatalogResponse)(nil),             // 60: service.ListItemCatalogResponse
+	(*CraftItemResponse)(nil),                   // 61: service.CraftItemResponse
+	(*ListRecipesResponse)(nil),                 // 62: service.ListRecipesResponse
+	(*ExchangeForEnergyResponse)(nil),           // 63: service.ExchangeForEnergyResponse
+	(*EnergyState)(nil),                         // 64: service.EnergyState
+	(*EnergyHold)(nil),                          // 65: service.EnergyHold
+	(*LedgerEntry)(nil),                         // 66: service.LedgerEntry
+	(*LedgerPoolChange)(nil),                    // 67: service.LedgerPoolChange
+	(*RegenBoost)(nil),                          // 68: service.RegenBoost
+	(*EnergyConfig)(nil),                        // 69: service.EnergyConfig
+	(*NamespaceEnergyConfig)(nil),               // 70: service.NamespaceEnergyConfig
+	(*CatalogItem)(nil),                         // 71: service.CatalogItem
+	(*Recipe)(nil),                              // 72: service.Recipe
+	nil,                                         // 73: service.BatchActionResult.CostsEntry
+	nil,                                         // 74: service.EnergyHold.CostsEntry
+	nil,                                         // 75: service.CatalogItem.LocalizedNamesEntry
+	nil,                                         // 76: service.Recipe.EnergyCostsEntry
 }
 var file_service_proto_depIdxs = []int32{
-	20, // 0: service.GetEnergyResponse.energy_state:type_name -> service.EnergyState
-	20, // 1: service.ConsumeEnergyResponse.energy_state:type_name -> service.EnergyState
-	13, // 2: service.ConsumeEnergyResponse.loot:type_name -> service.LootItem
-	20, // 3: service.RefillEnergyResponse.energy_state:type_name -> service.EnergyState
-	21, // 4: service.GetEnergyConfigResponse.config:type_name -> service.EnergyConfig
-	17, // 5: service.GetInventoryResponse.items:type_name -> service.InventoryItem
-	21, // 6: service.UpdateEnergyConfigResponse.config:type_name -> service.EnergyConfig
-	20, // 7: service.ResetEnergyResponse.energy_state:type_name -> service.EnergyState
-	0,  // 8: service.Service.GetMyEnergy:input_type -> service.GetMyEnergyRequest
-	1,  // 9: service.Service.ConsumeMyEnergy:input_type -> service.ConsumeMyEnergyRequest
-	2,  // 10: service.Service.RefillMyEnergy:input_type -> service.RefillMyEnergyRequest
-	4,  // 11: service.Service.GetMyInventory:input_type -> service.GetMyInventoryRequest
-	3,  // 12: service.Service.GetMyEnergyConfig:input_type -> service.GetMyEnergyConfigRequest
-	5,  // 13: service.Service.GetEnergy:input_type -> service.GetEnergyRequest
-	6,  // 14: service.Service.ConsumeEnergy:input_type -> service.ConsumeEnergyRequest
-	7,  // 15: service.Service.RefillEnergy:input_type -> service.RefillEnergyRequest
-	8,  // 16: service.Service.GetEnergyConfig:input_type -> service.GetEnergyConfigRequest
-	9,  // 17: service.Service.UpdateEnergyConfig:input_type -> service.UpdateEnergyConfigRequest
-	10, // 18: service.Service.ResetEnergy:input_type -> service.ResetEnergyRequest
-	11, // 19: service.Service.GetMyEnergy:output_type -> service.GetEnergyResponse
-	12, // 20: service.Service.ConsumeMyEnergy:output_type -> service.ConsumeEnergyResponse
-	14, // 21: service.Service.RefillMyEnergy:output_type -> service.RefillEnergyResponse
-	16, // 22: service.Service.GetMyInventory:output_type -> service.GetInventoryResponse
-	15, // 23: service.Service.GetMyEnergyConfig:output_type -> service.GetEnergyConfigResponse
-	11, // 24: service.Service.GetEnergy:output_type -> service.GetEnergyResponse
-	12, // 25: service.Service.ConsumeEnergy:output_type -> service.ConsumeEnergyResponse
-	14, // 26: service.Service.RefillEnergy:output_type -> service.RefillEnergyResponse
-	15, // 27: service.Service.GetEnergyConfig:output_type -> service.GetEnergyConfigResponse
-	18, // 28: service.Service.UpdateEnergyConfig:output_type -> service.UpdateEnergyConfigResponse
-	19, // 29: service.Service.ResetEnergy:output_type -> service.ResetEnergyResponse
-	19, // [19:30] is the sub-list for method output_type
-	8,  // [8:19] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	4,  // 0: service.ConsumeMyEnergyBatchRequest.actions:type_name -> service.BatchAction
+	0,  // 1: service.ConsumeMyEnergyBatchRequest.mode:type_name -> service.BatchMode
+	48, // 2: service.SpendMyItemsRequest.items:type_name -> service.ItemQuantity
+	48, // 3: service.GrantItemsRequest.items:type_name -> service.ItemQuantity
+	48, // 4: service.RevokeItemsRequest.items:type_name -> service.ItemQuantity
+	64, // 5: service.GetEnergyResponse.energy_state:type_name -> service.EnergyState
+	64, // 6: service.GetEnergyResponse.pools:type_name -> service.EnergyState
+	64, // 7: service.ConsumeEnergyResponse.energy_state:type_name -> service.EnergyState
+	43, // 8: service.ConsumeEnergyResponse.loot:type_name -> service.LootItem
+	64, // 9: service.ConsumeEnergyResponse.pools:type_name -> service.EnergyState
+	65, // 10: service.ReserveEnergyResponse.hold:type_name -> service.EnergyHold
+	64, // 11: service.ReserveEnergyResponse.energy_state:type_name -> service.EnergyState
+	64, // 12: service.ReserveEnergyResponse.pools:type_name -> service.EnergyState
+	65, // 13: service.CommitEnergyResponse.hold:type_name -> service.EnergyHold
+	43, // 14: service.CommitEnergyResponse.loot:type_name -> service.LootItem
+	65, // 15: service.ReleaseEnergyResponse.hold:type_name -> service.EnergyHold
+	64, // 16: service.ReleaseEnergyResponse.pools:type_name -> service.EnergyState
+	64, // 17: service.ConsumeEnergyBatchResponse.energy_state:type_name -> service.EnergyState
+	43, // 18: service.ConsumeEnergyBatchResponse.loot:type_name -> service.LootItem
+	64, // 19: service.ConsumeEnergyBatchResponse.pools:type_name -> service.EnergyState
+	42, // 20: service.ConsumeEnergyBatchResponse.results:type_name -> service.BatchActionResult
+	73, // 21: service.BatchActionResult.costs:type_name -> service.BatchActionResult.CostsEntry
+	43, // 22: service.BatchActionResult.loot:type_name -> service.LootItem
+	64, // 23: service.RefillEnergyResponse.energy_state:type_name -> service.EnergyState
+	64, // 24: service.RefillEnergyResponse.pools:type_name -> service.EnergyState
+	69, // 25: service.GetEnergyConfigResponse.config:type_name -> service.EnergyConfig
+	47, // 26: service.GetInventoryResponse.items:type_name -> service.InventoryItem
+	47, // 27: service.GetInventoryResponse.pending_items:type_name -> service.InventoryItem
+	69, // 28: service.UpdateEnergyConfigResponse.config:type_name -> service.EnergyConfig
+	64, // 29: service.SetEnergyLevelResponse.energy_state:type_name -> service.EnergyState
+	69, // 30: service.SetEnergyLevelResponse.config:type_name -> service.EnergyConfig
+	64, // 31: service.ResetEnergyResponse.energy_state:type_name -> service.EnergyState
+	47, // 32: service.UpdateInventoryResponse.items:type_name -> service.InventoryItem
+	49, // 33: service.UpdateInventoryResponse.shortfalls:type_name -> service.ItemShortfall
+	47, // 34: service.UpdateInventoryResponse.pending_items:type_name -> service.InventoryItem
+	66, // 35: service.ListEnergyHistoryResponse.entries:type_name -> service.LedgerEntry
+	70, // 36: service.GetNamespaceEnergyConfigResponse.config:type_name -> service.NamespaceEnergyConfig
+	68, // 37: service.RegenBoostResponse.boost:type_name -> service.RegenBoost
+	68, // 38: service.ListRegenBoostsResponse.boosts:type_name -> service.RegenBoost
+	70, // 39: service.UpdateNamespaceEnergyConfigResponse.config:type_name -> service.NamespaceEnergyConfig
+	71, // 40: service.ListItemCatalogResponse.items:type_name -> service.CatalogItem
+	64, // 41: service.CraftItemResponse.energy_state:type_name -> service.EnergyState
+	43, // 42: service.CraftItemResponse.outputs:type_name -> service.LootItem
+	47, // 43: service.CraftItemResponse.items:type_name -> service.InventoryItem
+	64, // 44: service.CraftItemResponse.pools:type_name -> service.EnergyState
+	49, // 45: service.CraftItemResponse.shortfalls:type_name -> service.ItemShortfall
+	72, // 46: service.ListRecipesResponse.recipes:type_name -> service.Recipe
+	64, // 47: service.ExchangeForEnergyResponse.energy_state:type_name -> service.EnergyState
+	64, // 48: service.ExchangeForEnergyResponse.pools:type_name -> service.EnergyState
+	47, // 49: service.ExchangeForEnergyResponse.items:type_name -> service.InventoryItem
+	49, // 50: service.ExchangeForEnergyResponse.shortfalls:type_name -> service.ItemShortfall
+	74, // 51: service.EnergyHold.costs:type_name -> service.EnergyHold.CostsEntry
+	67, // 52: service.LedgerEntry.pools:type_name -> service.LedgerPoolChange
+	43, // 53: service.LedgerEntry.items:type_name -> service.LootItem
+	75, // 54: service.CatalogItem.localized_names:type_name -> service.CatalogItem.LocalizedNamesEntry
+	43, // 55: service.Recipe.inputs:type_name -> service.LootItem
+	43, // 56: service.Recipe.outputs:type_name -> service.LootItem
+	76, // 57: service.Recipe.energy_costs:type_name -> service.Recipe.EnergyCostsEntry
+	49, // 58: service.Recipe.shortfalls:type_name -> service.ItemShortfall
+	1,  // 59: service.Service.GetMyEnergy:input_type -> service.GetMyEnergyRequest
+	2,  // 60: service.Service.ConsumeMyEnergy:input_type -> service.ConsumeMyEnergyRequest
+	3,  // 61: service.Service.ConsumeMyEnergyBatch:input_type -> service.ConsumeMyEnergyBatchRequest
+	7,  // 62: service.Service.RefillMyEnergy:input_type -> service.RefillMyEnergyRequest
+	9,  // 63: service.Service.GetMyInventory:input_type -> service.GetMyInventoryRequest
+	5,  // 64: service.Service.SpendMyItems:input_type -> service.SpendMyItemsRequest
+	6,  // 65: service.Service.ClaimMyPendingItems:input_type -> service.ClaimMyPendingItemsRequest
+	8,  // 66: service.Service.GetMyEnergyConfig:input_type -> service.GetMyEnergyConfigRequest
+	10, // 67: service.Service.LevelUpMyEnergy:input_type -> service.LevelUpMyEnergyRequest
+	11, // 68: service.Service.ReserveEnergy:input_type -> service.ReserveEnergyRequest
+	12, // 69: service.Service.CommitEnergy:input_type -> service.CommitEnergyRequest
+	13, // 70: service.Service.ReleaseEnergy:input_type -> service.ReleaseEnergyRequest
+	14, // 71: service.Service.ListMyEnergyHistory:input_type -> service.ListMyEnergyHistoryRequest
+	15, // 72: service.Service.ListItemCatalog:input_type -> service.ListItemCatalogRequest
+	16, // 73: service.Service.CraftItem:input_type -> service.CraftItemRequest
+	17, // 74: service.Service.ListRecipes:input_type -> service.ListRecipesRequest
+	18, // 75: service.Service.ExchangeForEnergy:input_type -> service.ExchangeForEnergyRequest
+	19, // 76: service.Service.GetEnergy:input_type -> service.GetEnergyRequest
+	20, // 77: service.Service.ConsumeEnergy:input_type -> service.ConsumeEnergyRequest
+	21, // 78: service.Service.RefillEnergy:input_type -> service.RefillEnergyRequest
+	22, // 79: service.Service.GetEnergyConfig:input_type -> service.GetEnergyConfigRequest
+	23, // 80: service.Service.UpdateEnergyConfig:input_type -> service.UpdateEnergyConfigRequest
+	24, // 81: service.Service.SetEnergyLevel:input_type -> service.SetEnergyLevelRequest
+	25, // 82: service.Service.ResetEnergy:input_type -> service.ResetEnergyRequest
+	26, // 83: service.Service.GrantItems:input_type -> service.GrantItemsRequest
+	27, // 84: service.Service.RevokeItems:input_type -> service.RevokeItemsRequest
+	28, // 85: service.Service.ListEnergyTransactions:input_type -> service.ListEnergyTransactionsRequest
+	29, // 86: service.Service.GetNamespaceEnergyConfig:input_type -> service.GetNamespaceEnergyConfigRequest
+	35, // 87: service.Service.UpdateNamespaceEnergyConfig:input_type -> service.UpdateNamespaceEnergyConfigRequest
+	30, // 88: service.Service.CreateRegenBoost:input_type -> service.CreateRegenBoostRequest
+	31, // 89: service.Service.ListRegenBoosts:input_type -> service.ListRegenBoostsRequest
+	32, // 90: service.Service.CancelRegenBoost:input_type -> service.CancelRegenBoostRequest
+	33, // 91: service.Service.GetDebugTimeOffset:input_type -> service.GetDebugTimeOffsetRequest
+	34, // 92: service.Service.SetDebugTimeOffset:input_type -> service.SetDebugTimeOffsetRequest
+	36, // 93: service.Service.GetMyEnergy:output_type -> service.GetEnergyResponse
+	37, // 94: service.Service.ConsumeMyEnergy:output_type -> service.ConsumeEnergyResponse
+	41, // 95: service.Service.ConsumeMyEnergyBatch:output_type -> service.ConsumeEnergyBatchResponse
+	44, // 96: service.Service.RefillMyEnergy:output_type -> service.RefillEnergyResponse
+	46, // 97: service.Service.GetMyInventory:output_type -> service.GetInventoryResponse
+	53, // 98: service.Service.SpendMyItems:output_type -> service.UpdateInventoryResponse
+	53, // 99: service.Service.ClaimMyPendingItems:output_type -> service.UpdateInventoryResponse
+	45, // 100: service.Service.GetMyEnergyConfig:output_type -> service.GetEnergyConfigResponse
+	51, // 101: service.Service.LevelUpMyEnergy:output_type -> service.SetEnergyLevelResponse
+	38, // 102: service.Service.ReserveEnergy:output_type -> service.ReserveEnergyResponse
+	39, // 103: service.Service.CommitEnergy:output_type -> service.CommitEnergyResponse
+	40, // 104: service.Service.ReleaseEnergy:output_type -> service.ReleaseEnergyResponse
+	54, // 105: service.Service.ListMyEnergyHistory:output_type -> service.ListEnergyHistoryResponse
+	60, // 106: service.Service.ListItemCatalog:output_type -> service.ListItemCatalogResponse
+	61, // 107: service.Service.CraftItem:output_type -> service.CraftItemResponse
+	62, // 108: service.Service.ListRecipes:output_type -> service.ListRecipesResponse
+	63, // 109: service.Service.ExchangeForEnergy:output_type -> service.ExchangeForEnergyResponse
+	36, // 110: service.Service.GetEnergy:output_type -> service.GetEnergyResponse
+	37, // 111: service.Service.ConsumeEnergy:output_type -> service.ConsumeEnergyResponse
+	44, // 112: service.Service.RefillEnergy:output_type -> service.RefillEnergyResponse
+	45, // 113: service.Service.GetEnergyConfig:output_type -> service.GetEnergyConfigResponse
+	50, // 114: service.Service.UpdateEnergyConfig:output_type -> service.UpdateEnergyConfigResponse
+	51, // 115: service.Service.SetEnergyLevel:output_type -> service.SetEnergyLevelResponse
+	52, // 116: service.Service.ResetEnergy:output_type -> service.ResetEnergyResponse
+	53, // 117: service.Service.GrantItems:output_type -> service.UpdateInventoryResponse
+	53, // 118: service.Service.RevokeItems:output_type -> service.UpdateInventoryResponse
+	54, // 119: service.Service.ListEnergyTransactions:output_type -> service.ListEnergyHistoryResponse
+	55, // 120: service.Service.GetNamespaceEnergyConfig:output_type -> service.GetNamespaceEnergyConfigResponse
+	59, // 121: service.Service.UpdateNamespaceEnergyConfig:output_type -> service.UpdateNamespaceEnergyConfigResponse
+	56, // 122: service.Service.CreateRegenBoost:output_type -> service.RegenBoostResponse
+	57, // 123: service.Service.ListRegenBoosts:output_type -> service.ListRegenBoostsResponse
+	56, // 124: service.Service.CancelRegenBoost:output_type -> service.RegenBoostResponse
+	58, // 125: service.Service.GetDebugTimeOffset:output_type -> service.DebugTimeOffsetResponse
+	58, // 126: service.Service.SetDebugTimeOffset:output_type -> service.DebugTimeOffsetResponse
+	93, // [93:127] is the sub-list for method output_type
+	59, // [59:93] is the sub-list for method input_type
+	59, // [59:59] is the sub-list for extension type_name
+	59, // [59:59] is the sub-list for extension extendee
+	0,  // [0:59] is the sub-list for field type_name
 }
 
 func init() { file_service_proto_init() }
@@ -1592,13 +5956,14 @@ func file_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_service_proto_rawDesc), len(file_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   22,
+			NumEnums:      1,
+			NumMessages:   76,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_service_proto_goTypes,
 		DependencyIndexes: file_service_proto_depIdxs,
+		EnumInfos:         file_service_proto_enumTypes,
 		MessageInfos:      file_service_proto_msgTypes,
 	}.Build()
 	File_service_proto = out.File
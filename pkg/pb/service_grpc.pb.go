@@ -23,17 +23,40 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Service_GetMyEnergy_FullMethodName        = "/service.Service/GetMyEnergy"
-	Service_ConsumeMyEnergy_FullMethodName    = "/service.Service/ConsumeMyEnergy"
-	Service_RefillMyEnergy_FullMethodName     = "/service.Service/RefillMyEnergy"
-	Service_GetMyInventory_FullMethodName     = "/service.Service/GetMyInventory"
-	Service_GetMyEnergyConfig_FullMethodName  = "/service.Service/GetMyEnergyConfig"
-	Service_GetEnergy_FullMethodName          = "/service.Service/GetEnergy"
-	Service_ConsumeEnergy_FullMethodName      = "/service.Service/ConsumeEnergy"
-	Service_RefillEnergy_FullMethodName       = "/service.Service/RefillEnergy"
-	Service_GetEnergyConfig_FullMethodName    = "/service.Service/GetEnergyConfig"
-	Service_UpdateEnergyConfig_FullMethodName = "/service.Service/UpdateEnergyConfig"
-	Service_ResetEnergy_FullMethodName        = "/service.Service/ResetEnergy"
+	Service_GetMyEnergy_FullMethodName                 = "/service.Service/GetMyEnergy"
+	Service_ConsumeMyEnergy_FullMethodName             = "/service.Service/ConsumeMyEnergy"
+	Service_ConsumeMyEnergyBatch_FullMethodName        = "/service.Service/ConsumeMyEnergyBatch"
+	Service_RefillMyEnergy_FullMethodName              = "/service.Service/RefillMyEnergy"
+	Service_GetMyInventory_FullMethodName              = "/service.Service/GetMyInventory"
+	Service_SpendMyItems_FullMethodName                = "/service.Service/SpendMyItems"
+	Service_ClaimMyPendingItems_FullMethodName         = "/service.Service/ClaimMyPendingItems"
+	Service_GetMyEnergyConfig_FullMethodName           = "/service.Service/GetMyEnergyConfig"
+	Service_LevelUpMyEnergy_FullMethodName             = "/service.Service/LevelUpMyEnergy"
+	Service_ReserveEnergy_FullMethodName               = "/service.Service/ReserveEnergy"
+	Service_CommitEnergy_FullMethodName                = "/service.Service/CommitEnergy"
+	Service_ReleaseEnergy_FullMethodName               = "/service.Service/ReleaseEnergy"
+	Service_ListMyEnergyHistory_FullMethodName         = "/service.Service/ListMyEnergyHistory"
+	Service_ListItemCatalog_FullMethodName             = "/service.Service/ListItemCatalog"
+	Service_CraftItem_FullMethodName                   = "/service.Service/CraftItem"
+	Service_ListRecipes_FullMethodName                 = "/service.Service/ListRecipes"
+	Service_ExchangeForEnergy_FullMethodName           = "/service.Service/ExchangeForEnergy"
+	Service_GetEnergy_FullMethodName                   = "/service.Service/GetEnergy"
+	Service_ConsumeEnergy_FullMethodName               = "/service.Service/ConsumeEnergy"
+	Service_RefillEnergy_FullMethodName                = "/service.Service/RefillEnergy"
+	Service_GetEnergyConfig_FullMethodName             = "/service.Service/GetEnergyConfig"
+	Service_UpdateEnergyConfig_FullMethodName          = "/service.Service/UpdateEnergyConfig"
+	Service_SetEnergyLevel_FullMethodName              = "/service.Service/SetEnergyLevel"
+	Service_ResetEnergy_FullMethodName                 = "/service.Service/ResetEnergy"
+	Service_GrantItems_FullMethodName                  = "/service.Service/GrantItems"
+	Service_RevokeItems_FullMethodName                 = "/service.Service/RevokeItems"
+	Service_ListEnergyTransactions_FullMethodName      = "/service.Service/ListEnergyTransactions"
+	Service_GetNamespaceEnergyConfig_FullMethodName    = "/service.Service/GetNamespaceEnergyConfig"
+	Service_UpdateNamespaceEnergyConfig_FullMethodName = "/service.Service/UpdateNamespaceEnergyConfig"
+	Service_CreateRegenBoost_FullMethodName            = "/service.Service/CreateRegenBoost"
+	Service_ListRegenBoosts_FullMethodName             = "/service.Service/ListRegenBoosts"
+	Service_CancelRegenBoost_FullMethodName            = "/service.Service/CancelRegenBoost"
+	Service_GetDebugTimeOffset_FullMethodName          = "/service.Service/GetDebugTimeOffset"
+	Service_SetDebugTimeOffset_FullMethodName          = "/service.Service/SetDebugTimeOffset"
 )
 
 // ServiceClient is the client API for Service service.
@@ -44,12 +67,36 @@ type ServiceClient interface {
 	GetMyEnergy(ctx context.Context, in *GetMyEnergyRequest, opts ...grpc.CallOption) (*GetEnergyResponse, error)
 	// Consume my energy for an action
 	ConsumeMyEnergy(ctx context.Context, in *ConsumeMyEnergyRequest, opts ...grpc.CallOption) (*ConsumeEnergyResponse, error)
+	// Consume my energy for several actions in one request
+	ConsumeMyEnergyBatch(ctx context.Context, in *ConsumeMyEnergyBatchRequest, opts ...grpc.CallOption) (*ConsumeEnergyBatchResponse, error)
 	// Refill my energy (from purchase, reward, etc.)
 	RefillMyEnergy(ctx context.Context, in *RefillMyEnergyRequest, opts ...grpc.CallOption) (*RefillEnergyResponse, error)
 	// Get my inventory
 	GetMyInventory(ctx context.Context, in *GetMyInventoryRequest, opts ...grpc.CallOption) (*GetInventoryResponse, error)
+	// Spend items from my inventory
+	SpendMyItems(ctx context.Context, in *SpendMyItemsRequest, opts ...grpc.CallOption) (*UpdateInventoryResponse, error)
+	// Claim my pending items
+	ClaimMyPendingItems(ctx context.Context, in *ClaimMyPendingItemsRequest, opts ...grpc.CallOption) (*UpdateInventoryResponse, error)
 	// Get my energy configuration
 	GetMyEnergyConfig(ctx context.Context, in *GetMyEnergyConfigRequest, opts ...grpc.CallOption) (*GetEnergyConfigResponse, error)
+	// Upgrade my energy level by spending items
+	LevelUpMyEnergy(ctx context.Context, in *LevelUpMyEnergyRequest, opts ...grpc.CallOption) (*SetEnergyLevelResponse, error)
+	// Hold energy for an action that resolves later
+	ReserveEnergy(ctx context.Context, in *ReserveEnergyRequest, opts ...grpc.CallOption) (*ReserveEnergyResponse, error)
+	// Finalize a hold and receive the action's loot
+	CommitEnergy(ctx context.Context, in *CommitEnergyRequest, opts ...grpc.CallOption) (*CommitEnergyResponse, error)
+	// Cancel a hold and refund its energy
+	ReleaseEnergy(ctx context.Context, in *ReleaseEnergyRequest, opts ...grpc.CallOption) (*ReleaseEnergyResponse, error)
+	// List my energy history
+	ListMyEnergyHistory(ctx context.Context, in *ListMyEnergyHistoryRequest, opts ...grpc.CallOption) (*ListEnergyHistoryResponse, error)
+	// List the item catalog
+	ListItemCatalog(ctx context.Context, in *ListItemCatalogRequest, opts ...grpc.CallOption) (*ListItemCatalogResponse, error)
+	// Craft an item from a recipe
+	CraftItem(ctx context.Context, in *CraftItemRequest, opts ...grpc.CallOption) (*CraftItemResponse, error)
+	// List crafting recipes
+	ListRecipes(ctx context.Context, in *ListRecipesRequest, opts ...grpc.CallOption) (*ListRecipesResponse, error)
+	// Exchange items for energy
+	ExchangeForEnergy(ctx context.Context, in *ExchangeForEnergyRequest, opts ...grpc.CallOption) (*ExchangeForEnergyResponse, error)
 	// Get player's energy state (admin)
 	GetEnergy(ctx context.Context, in *GetEnergyRequest, opts ...grpc.CallOption) (*GetEnergyResponse, error)
 	// Consume player's energy (admin)
@@ -60,8 +107,30 @@ type ServiceClient interface {
 	GetEnergyConfig(ctx context.Context, in *GetEnergyConfigRequest, opts ...grpc.CallOption) (*GetEnergyConfigResponse, error)
 	// Update player's energy configuration (admin)
 	UpdateEnergyConfig(ctx context.Context, in *UpdateEnergyConfigRequest, opts ...grpc.CallOption) (*UpdateEnergyConfigResponse, error)
+	// Set player's energy level (admin)
+	SetEnergyLevel(ctx context.Context, in *SetEnergyLevelRequest, opts ...grpc.CallOption) (*SetEnergyLevelResponse, error)
 	// Reset player's energy state (admin only)
 	ResetEnergy(ctx context.Context, in *ResetEnergyRequest, opts ...grpc.CallOption) (*ResetEnergyResponse, error)
+	// Grant items to a player (admin)
+	GrantItems(ctx context.Context, in *GrantItemsRequest, opts ...grpc.CallOption) (*UpdateInventoryResponse, error)
+	// Revoke items from a player (admin)
+	RevokeItems(ctx context.Context, in *RevokeItemsRequest, opts ...grpc.CallOption) (*UpdateInventoryResponse, error)
+	// List a player's energy transactions (admin)
+	ListEnergyTransactions(ctx context.Context, in *ListEnergyTransactionsRequest, opts ...grpc.CallOption) (*ListEnergyHistoryResponse, error)
+	// Get the namespace-wide default energy configuration (admin)
+	GetNamespaceEnergyConfig(ctx context.Context, in *GetNamespaceEnergyConfigRequest, opts ...grpc.CallOption) (*GetNamespaceEnergyConfigResponse, error)
+	// Update the namespace-wide default energy configuration (admin)
+	UpdateNamespaceEnergyConfig(ctx context.Context, in *UpdateNamespaceEnergyConfigRequest, opts ...grpc.CallOption) (*UpdateNamespaceEnergyConfigResponse, error)
+	// Create a regen boost for a player or a namespace-wide regen event (admin)
+	CreateRegenBoost(ctx context.Context, in *CreateRegenBoostRequest, opts ...grpc.CallOption) (*RegenBoostResponse, error)
+	// List regen boosts of a player or the namespace (admin)
+	ListRegenBoosts(ctx context.Context, in *ListRegenBoostsRequest, opts ...grpc.CallOption) (*ListRegenBoostsResponse, error)
+	// Cancel a regen boost of a player or the namespace (admin)
+	CancelRegenBoost(ctx context.Context, in *CancelRegenBoostRequest, opts ...grpc.CallOption) (*RegenBoostResponse, error)
+	// Get the debug time offset of a namespace (admin)
+	GetDebugTimeOffset(ctx context.Context, in *GetDebugTimeOffsetRequest, opts ...grpc.CallOption) (*DebugTimeOffsetResponse, error)
+	// Move the service clock of a namespace ahead for testing (admin)
+	SetDebugTimeOffset(ctx context.Context, in *SetDebugTimeOffsetRequest, opts ...grpc.CallOption) (*DebugTimeOffsetResponse, error)
 }
 
 type serviceClient struct {
@@ -92,6 +161,16 @@ func (c *serviceClient) ConsumeMyEnergy(ctx context.Context, in *ConsumeMyEnergy
 	return out, nil
 }
 
+func (c *serviceClient) ConsumeMyEnergyBatch(ctx context.Context, in *ConsumeMyEnergyBatchRequest, opts ...grpc.CallOption) (*ConsumeEnergyBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConsumeEnergyBatchResponse)
+	err := c.cc.Invoke(ctx, Service_ConsumeMyEnergyBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serviceClient) RefillMyEnergy(ctx context.Context, in *RefillMyEnergyRequest, opts ...grpc.CallOption) (*RefillEnergyResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RefillEnergyResponse)
@@ -112,6 +191,26 @@ func (c *serviceClient) GetMyInventory(ctx context.Context, in *GetMyInventoryRe
 	return out, nil
 }
 
+func (c *serviceClient) SpendMyItems(ctx context.Context, in *SpendMyItemsRequest, opts ...grpc.CallOption) (*UpdateInventoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateInventoryResponse)
+	err := c.cc.Invoke(ctx, Service_SpendMyItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ClaimMyPendingItems(ctx context.Context, in *ClaimMyPendingItemsRequest, opts ...grpc.CallOption) (*UpdateInventoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateInventoryResponse)
+	err := c.cc.Invoke(ctx, Service_ClaimMyPendingItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serviceClient) GetMyEnergyConfig(ctx context.Context, in *GetMyEnergyConfigRequest, opts ...grpc.CallOption) (*GetEnergyConfigResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetEnergyConfigResponse)
@@ -122,6 +221,96 @@ func (c *serviceClient) GetMyEnergyConfig(ctx context.Context, in *GetMyEnergyCo
 	return out, nil
 }
 
+func (c *serviceClient) LevelUpMyEnergy(ctx context.Context, in *LevelUpMyEnergyRequest, opts ...grpc.CallOption) (*SetEnergyLevelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetEnergyLevelResponse)
+	err := c.cc.Invoke(ctx, Service_LevelUpMyEnergy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ReserveEnergy(ctx context.Context, in *ReserveEnergyRequest, opts ...grpc.CallOption) (*ReserveEnergyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReserveEnergyResponse)
+	err := c.cc.Invoke(ctx, Service_ReserveEnergy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) CommitEnergy(ctx context.Context, in *CommitEnergyRequest, opts ...grpc.CallOption) (*CommitEnergyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommitEnergyResponse)
+	err := c.cc.Invoke(ctx, Service_CommitEnergy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ReleaseEnergy(ctx context.Context, in *ReleaseEnergyRequest, opts ...grpc.CallOption) (*ReleaseEnergyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseEnergyResponse)
+	err := c.cc.Invoke(ctx, Service_ReleaseEnergy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ListMyEnergyHistory(ctx context.Context, in *ListMyEnergyHistoryRequest, opts ...grpc.CallOption) (*ListEnergyHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEnergyHistoryResponse)
+	err := c.cc.Invoke(ctx, Service_ListMyEnergyHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ListItemCatalog(ctx context.Context, in *ListItemCatalogRequest, opts ...grpc.CallOption) (*ListItemCatalogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListItemCatalogResponse)
+	err := c.cc.Invoke(ctx, Service_ListItemCatalog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) CraftItem(ctx context.Context, in *CraftItemRequest, opts ...grpc.CallOption) (*CraftItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CraftItemResponse)
+	err := c.cc.Invoke(ctx, Service_CraftItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ListRecipes(ctx context.Context, in *ListRecipesRequest, opts ...grpc.CallOption) (*ListRecipesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecipesResponse)
+	err := c.cc.Invoke(ctx, Service_ListRecipes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ExchangeForEnergy(ctx context.Context, in *ExchangeForEnergyRequest, opts ...grpc.CallOption) (*ExchangeForEnergyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExchangeForEnergyResponse)
+	err := c.cc.Invoke(ctx, Service_ExchangeForEnergy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serviceClient) GetEnergy(ctx context.Context, in *GetEnergyRequest, opts ...grpc.CallOption) (*GetEnergyResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetEnergyResponse)
@@ -172,6 +361,16 @@ func (c *serviceClient) UpdateEnergyConfig(ctx context.Context, in *UpdateEnergy
 	return out, nil
 }
 
+func (c *serviceClient) SetEnergyLevel(ctx context.Context, in *SetEnergyLevelRequest, opts ...grpc.CallOption) (*SetEnergyLevelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetEnergyLevelResponse)
+	err := c.cc.Invoke(ctx, Service_SetEnergyLevel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serviceClient) ResetEnergy(ctx context.Context, in *ResetEnergyRequest, opts ...grpc.CallOption) (*ResetEnergyResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ResetEnergyResponse)
@@ -182,6 +381,106 @@ func (c *serviceClient) ResetEnergy(ctx context.Context, in *ResetEnergyRequest,
 	return out, nil
 }
 
+func (c *serviceClient) GrantItems(ctx context.Context, in *GrantItemsRequest, opts ...grpc.CallOption) (*UpdateInventoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateInventoryResponse)
+	err := c.cc.Invoke(ctx, Service_GrantItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RevokeItems(ctx context.Context, in *RevokeItemsRequest, opts ...grpc.CallOption) (*UpdateInventoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateInventoryResponse)
+	err := c.cc.Invoke(ctx, Service_RevokeItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ListEnergyTransactions(ctx context.Context, in *ListEnergyTransactionsRequest, opts ...grpc.CallOption) (*ListEnergyHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEnergyHistoryResponse)
+	err := c.cc.Invoke(ctx, Service_ListEnergyTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) GetNamespaceEnergyConfig(ctx context.Context, in *GetNamespaceEnergyConfigRequest, opts ...grpc.CallOption) (*GetNamespaceEnergyConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNamespaceEnergyConfigResponse)
+	err := c.cc.Invoke(ctx, Service_GetNamespaceEnergyConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) UpdateNamespaceEnergyConfig(ctx context.Context, in *UpdateNamespaceEnergyConfigRequest, opts ...grpc.CallOption) (*UpdateNamespaceEnergyConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateNamespaceEnergyConfigResponse)
+	err := c.cc.Invoke(ctx, Service_UpdateNamespaceEnergyConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) CreateRegenBoost(ctx context.Context, in *CreateRegenBoostRequest, opts ...grpc.CallOption) (*RegenBoostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegenBoostResponse)
+	err := c.cc.Invoke(ctx, Service_CreateRegenBoost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ListRegenBoosts(ctx context.Context, in *ListRegenBoostsRequest, opts ...grpc.CallOption) (*ListRegenBoostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRegenBoostsResponse)
+	err := c.cc.Invoke(ctx, Service_ListRegenBoosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) CancelRegenBoost(ctx context.Context, in *CancelRegenBoostRequest, opts ...grpc.CallOption) (*RegenBoostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegenBoostResponse)
+	err := c.cc.Invoke(ctx, Service_CancelRegenBoost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) GetDebugTimeOffset(ctx context.Context, in *GetDebugTimeOffsetRequest, opts ...grpc.CallOption) (*DebugTimeOffsetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DebugTimeOffsetResponse)
+	err := c.cc.Invoke(ctx, Service_GetDebugTimeOffset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) SetDebugTimeOffset(ctx context.Context, in *SetDebugTimeOffsetRequest, opts ...grpc.CallOption) (*DebugTimeOffsetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DebugTimeOffsetResponse)
+	err := c.cc.Invoke(ctx, Service_SetDebugTimeOffset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations should embed UnimplementedServiceServer
 // for forward compatibility.
@@ -190,12 +489,36 @@ type ServiceServer interface {
 	GetMyEnergy(context.Context, *GetMyEnergyRequest) (*GetEnergyResponse, error)
 	// Consume my energy for an action
 	ConsumeMyEnergy(context.Context, *ConsumeMyEnergyRequest) (*ConsumeEnergyResponse, error)
+	// Consume my energy for several actions in one request
+	ConsumeMyEnergyBatch(context.Context, *ConsumeMyEnergyBatchRequest) (*ConsumeEnergyBatchResponse, error)
 	// Refill my energy (from purchase, reward, etc.)
 	RefillMyEnergy(context.Context, *RefillMyEnergyRequest) (*RefillEnergyResponse, error)
 	// Get my inventory
 	GetMyInventory(context.Context, *GetMyInventoryRequest) (*GetInventoryResponse, error)
+	// Spend items from my inventory
+	SpendMyItems(context.Context, *SpendMyItemsRequest) (*UpdateInventoryResponse, error)
+	// Claim my pending items
+	ClaimMyPendingItems(context.Context, *ClaimMyPendingItemsRequest) (*UpdateInventoryResponse, error)
 	// Get my energy configuration
 	GetMyEnergyConfig(context.Context, *GetMyEnergyConfigRequest) (*GetEnergyConfigResponse, error)
+	// Upgrade my energy level by spending items
+	LevelUpMyEnergy(context.Context, *LevelUpMyEnergyRequest) (*SetEnergyLevelResponse, error)
+	// Hold energy for an action that resolves later
+	ReserveEnergy(context.Context, *ReserveEnergyRequest) (*ReserveEnergyResponse, error)
+	// Finalize a hold and receive the action's loot
+	CommitEnergy(context.Context, *CommitEnergyRequest) (*CommitEnergyResponse, error)
+	// Cancel a hold and refund its energy
+	ReleaseEnergy(context.Context, *ReleaseEnergyRequest) (*ReleaseEnergyResponse, error)
+	// List my energy history
+	ListMyEnergyHistory(context.Context, *ListMyEnergyHistoryRequest) (*ListEnergyHistoryResponse, error)
+	// List the item catalog
+	ListItemCatalog(context.Context, *ListItemCatalogRequest) (*ListItemCatalogResponse, error)
+	// Craft an item from a recipe
+	CraftItem(context.Context, *CraftItemRequest) (*CraftItemResponse, error)
+	// List crafting recipes
+	ListRecipes(context.Context, *ListRecipesRequest) (*ListRecipesResponse, error)
+	// Exchange items for energy
+	ExchangeForEnergy(context.Context, *ExchangeForEnergyRequest) (*ExchangeForEnergyResponse, error)
 	// Get player's energy state (admin)
 	GetEnergy(context.Context, *GetEnergyRequest) (*GetEnergyResponse, error)
 	// Consume player's energy (admin)
@@ -206,8 +529,30 @@ type ServiceServer interface {
 	GetEnergyConfig(context.Context, *GetEnergyConfigRequest) (*GetEnergyConfigResponse, error)
 	// Update player's energy configuration (admin)
 	UpdateEnergyConfig(context.Context, *UpdateEnergyConfigRequest) (*UpdateEnergyConfigResponse, error)
+	// Set player's energy level (admin)
+	SetEnergyLevel(context.Context, *SetEnergyLevelRequest) (*SetEnergyLevelResponse, error)
 	// Reset player's energy state (admin only)
 	ResetEnergy(context.Context, *ResetEnergyRequest) (*ResetEnergyResponse, error)
+	// Grant items to a player (admin)
+	GrantItems(context.Context, *GrantItemsRequest) (*UpdateInventoryResponse, error)
+	// Revoke items from a player (admin)
+	RevokeItems(context.Context, *RevokeItemsRequest) (*UpdateInventoryResponse, error)
+	// List a player's energy transactions (admin)
+	ListEnergyTransactions(context.Context, *ListEnergyTransactionsRequest) (*ListEnergyHistoryResponse, error)
+	// Get the namespace-wide default energy configuration (admin)
+	GetNamespaceEnergyConfig(context.Context, *GetNamespaceEnergyConfigRequest) (*GetNamespaceEnergyConfigResponse, error)
+	// Update the namespace-wide default energy configuration (admin)
+	UpdateNamespaceEnergyConfig(context.Context, *UpdateNamespaceEnergyConfigRequest) (*UpdateNamespaceEnergyConfigResponse, error)
+	// Create a regen boost for a player or a namespace-wide regen event (admin)
+	CreateRegenBoost(context.Context, *CreateRegenBoostRequest) (*RegenBoostResponse, error)
+	// List regen boosts of a player or the namespace (admin)
+	ListRegenBoosts(context.Context, *ListRegenBoostsRequest) (*ListRegenBoostsResponse, error)
+	// Cancel a regen boost of a player or the namespace (admin)
+	CancelRegenBoost(context.Context, *CancelRegenBoostRequest) (*RegenBoostResponse, error)
+	// Get the debug time offset of a namespace (admin)
+	GetDebugTimeOffset(context.Context, *GetDebugTimeOffsetRequest) (*DebugTimeOffsetResponse, error)
+	// Move the service clock of a namespace ahead for testing (admin)
+	SetDebugTimeOffset(context.Context, *SetDebugTimeOffsetRequest) (*DebugTimeOffsetResponse, error)
 }
 
 // UnimplementedServiceServer should be embedded to have
@@ -223,15 +568,51 @@ func (UnimplementedServiceServer) GetMyEnergy(context.Context, *GetMyEnergyReque
 func (UnimplementedServiceServer) ConsumeMyEnergy(context.Context, *ConsumeMyEnergyRequest) (*ConsumeEnergyResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ConsumeMyEnergy not implemented")
 }
+func (UnimplementedServiceServer) ConsumeMyEnergyBatch(context.Context, *ConsumeMyEnergyBatchRequest) (*ConsumeEnergyBatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConsumeMyEnergyBatch not implemented")
+}
 func (UnimplementedServiceServer) RefillMyEnergy(context.Context, *RefillMyEnergyRequest) (*RefillEnergyResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RefillMyEnergy not implemented")
 }
 func (UnimplementedServiceServer) GetMyInventory(context.Context, *GetMyInventoryRequest) (*GetInventoryResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetMyInventory not implemented")
 }
+func (UnimplementedServiceServer) SpendMyItems(context.Context, *SpendMyItemsRequest) (*UpdateInventoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SpendMyItems not implemented")
+}
+func (UnimplementedServiceServer) ClaimMyPendingItems(context.Context, *ClaimMyPendingItemsRequest) (*UpdateInventoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ClaimMyPendingItems not implemented")
+}
 func (UnimplementedServiceServer) GetMyEnergyConfig(context.Context, *GetMyEnergyConfigRequest) (*GetEnergyConfigResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetMyEnergyConfig not implemented")
 }
+func (UnimplementedServiceServer) LevelUpMyEnergy(context.Context, *LevelUpMyEnergyRequest) (*SetEnergyLevelResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LevelUpMyEnergy not implemented")
+}
+func (UnimplementedServiceServer) ReserveEnergy(context.Context, *ReserveEnergyRequest) (*ReserveEnergyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReserveEnergy not implemented")
+}
+func (UnimplementedServiceServer) CommitEnergy(context.Context, *CommitEnergyRequest) (*CommitEnergyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CommitEnergy not implemented")
+}
+func (UnimplementedServiceServer) ReleaseEnergy(context.Context, *ReleaseEnergyRequest) (*ReleaseEnergyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReleaseEnergy not implemented")
+}
+func (UnimplementedServiceServer) ListMyEnergyHistory(context.Context, *ListMyEnergyHistoryRequest) (*ListEnergyHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMyEnergyHistory not implemented")
+}
+func (UnimplementedServiceServer) ListItemCatalog(context.Context, *ListItemCatalogRequest) (*ListItemCatalogResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListItemCatalog not implemented")
+}
+func (UnimplementedServiceServer) CraftItem(context.Context, *CraftItemRequest) (*CraftItemResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CraftItem not implemented")
+}
+func (UnimplementedServiceServer) ListRecipes(context.Context, *ListRecipesRequest) (*ListRecipesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRecipes not implemented")
+}
+func (UnimplementedServiceServer) ExchangeForEnergy(context.Context, *ExchangeForEnergyRequest) (*ExchangeForEnergyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExchangeForEnergy not implemented")
+}
 func (UnimplementedServiceServer) GetEnergy(context.Context, *GetEnergyRequest) (*GetEnergyResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetEnergy not implemented")
 }
@@ -247,9 +628,42 @@ func (UnimplementedServiceServer) GetEnergyConfig(context.Context, *GetEnergyCon
 func (UnimplementedServiceServer) UpdateEnergyConfig(context.Context, *UpdateEnergyConfigRequest) (*UpdateEnergyConfigResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateEnergyConfig not implemented")
 }
+func (UnimplementedServiceServer) SetEnergyLevel(context.Context, *SetEnergyLevelRequest) (*SetEnergyLevelResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetEnergyLevel not implemented")
+}
 func (UnimplementedServiceServer) ResetEnergy(context.Context, *ResetEnergyRequest) (*ResetEnergyResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ResetEnergy not implemented")
 }
+func (UnimplementedServiceServer) GrantItems(context.Context, *GrantItemsRequest) (*UpdateInventoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GrantItems not implemented")
+}
+func (UnimplementedServiceServer) RevokeItems(context.Context, *RevokeItemsRequest) (*UpdateInventoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeItems not implemented")
+}
+func (UnimplementedServiceServer) ListEnergyTransactions(context.Context, *ListEnergyTransactionsRequest) (*ListEnergyHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListEnergyTransactions not implemented")
+}
+func (UnimplementedServiceServer) GetNamespaceEnergyConfig(context.Context, *GetNamespaceEnergyConfigRequest) (*GetNamespaceEnergyConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNamespaceEnergyConfig not implemented")
+}
+func (UnimplementedServiceServer) UpdateNamespaceEnergyConfig(context.Context, *UpdateNamespaceEnergyConfigRequest) (*UpdateNamespaceEnergyConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateNamespaceEnergyConfig not implemented")
+}
+func (UnimplementedServiceServer) CreateRegenBoost(context.Context, *CreateRegenBoostRequest) (*RegenBoostResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRegenBoost not implemented")
+}
+func (UnimplementedServiceServer) ListRegenBoosts(context.Context, *ListRegenBoostsRequest) (*ListRegenBoostsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRegenBoosts not implemented")
+}
+func (UnimplementedServiceServer) CancelRegenBoost(context.Context, *CancelRegenBoostRequest) (*RegenBoostResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelRegenBoost not implemented")
+}
+func (UnimplementedServiceServer) GetDebugTimeOffset(context.Context, *GetDebugTimeOffsetRequest) (*DebugTimeOffsetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDebugTimeOffset not implemented")
+}
+func (UnimplementedServiceServer) SetDebugTimeOffset(context.Context, *SetDebugTimeOffsetRequest) (*DebugTimeOffsetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetDebugTimeOffset not implemented")
+}
 func (UnimplementedServiceServer) testEmbeddedByValue() {}
 
 // UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -306,6 +720,24 @@ func _Service_ConsumeMyEnergy_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_ConsumeMyEnergyBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsumeMyEnergyBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ConsumeMyEnergyBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_ConsumeMyEnergyBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ConsumeMyEnergyBatch(ctx, req.(*ConsumeMyEnergyBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Service_RefillMyEnergy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RefillMyEnergyRequest)
 	if err := dec(in); err != nil {
@@ -342,6 +774,42 @@ func _Service_GetMyInventory_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_SpendMyItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpendMyItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SpendMyItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_SpendMyItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SpendMyItems(ctx, req.(*SpendMyItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ClaimMyPendingItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimMyPendingItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ClaimMyPendingItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_ClaimMyPendingItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ClaimMyPendingItems(ctx, req.(*ClaimMyPendingItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Service_GetMyEnergyConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetMyEnergyConfigRequest)
 	if err := dec(in); err != nil {
@@ -360,117 +828,477 @@ func _Service_GetMyEnergyConfig_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_GetEnergy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEnergyRequest)
+func _Service_LevelUpMyEnergy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LevelUpMyEnergyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).GetEnergy(ctx, in)
+		return srv.(ServiceServer).LevelUpMyEnergy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Service_GetEnergy_FullMethodName,
+		FullMethod: Service_LevelUpMyEnergy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).GetEnergy(ctx, req.(*GetEnergyRequest))
+		return srv.(ServiceServer).LevelUpMyEnergy(ctx, req.(*LevelUpMyEnergyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_ConsumeEnergy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConsumeEnergyRequest)
+func _Service_ReserveEnergy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveEnergyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).ConsumeEnergy(ctx, in)
+		return srv.(ServiceServer).ReserveEnergy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Service_ConsumeEnergy_FullMethodName,
+		FullMethod: Service_ReserveEnergy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).ConsumeEnergy(ctx, req.(*ConsumeEnergyRequest))
+		return srv.(ServiceServer).ReserveEnergy(ctx, req.(*ReserveEnergyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_RefillEnergy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RefillEnergyRequest)
+func _Service_CommitEnergy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitEnergyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).RefillEnergy(ctx, in)
+		return srv.(ServiceServer).CommitEnergy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Service_RefillEnergy_FullMethodName,
+		FullMethod: Service_CommitEnergy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).RefillEnergy(ctx, req.(*RefillEnergyRequest))
+		return srv.(ServiceServer).CommitEnergy(ctx, req.(*CommitEnergyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_GetEnergyConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEnergyConfigRequest)
+func _Service_ReleaseEnergy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseEnergyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).GetEnergyConfig(ctx, in)
+		return srv.(ServiceServer).ReleaseEnergy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Service_GetEnergyConfig_FullMethodName,
+		FullMethod: Service_ReleaseEnergy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).GetEnergyConfig(ctx, req.(*GetEnergyConfigRequest))
+		return srv.(ServiceServer).ReleaseEnergy(ctx, req.(*ReleaseEnergyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_UpdateEnergyConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateEnergyConfigRequest)
+func _Service_ListMyEnergyHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyEnergyHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).UpdateEnergyConfig(ctx, in)
+		return srv.(ServiceServer).ListMyEnergyHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Service_UpdateEnergyConfig_FullMethodName,
+		FullMethod: Service_ListMyEnergyHistory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).UpdateEnergyConfig(ctx, req.(*UpdateEnergyConfigRequest))
+		return srv.(ServiceServer).ListMyEnergyHistory(ctx, req.(*ListMyEnergyHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_ResetEnergy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResetEnergyRequest)
+func _Service_ListItemCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItemCatalogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).ResetEnergy(ctx, in)
+		return srv.(ServiceServer).ListItemCatalog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Service_ResetEnergy_FullMethodName,
+		FullMethod: Service_ListItemCatalog_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).ResetEnergy(ctx, req.(*ResetEnergyRequest))
+		return srv.(ServiceServer).ListItemCatalog(ctx, req.(*ListItemCatalogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// Service_ServiceDesc is the grpc.ServiceDesc for Service service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
+func _Service_CraftItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CraftItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).CraftItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_CraftItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).CraftItem(ctx, req.(*CraftItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ListRecipes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecipesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ListRecipes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_ListRecipes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ListRecipes(ctx, req.(*ListRecipesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ExchangeForEnergy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeForEnergyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ExchangeForEnergy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_ExchangeForEnergy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ExchangeForEnergy(ctx, req.(*ExchangeForEnergyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_GetEnergy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEnergyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetEnergy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_GetEnergy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetEnergy(ctx, req.(*GetEnergyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ConsumeEnergy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsumeEnergyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ConsumeEnergy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_ConsumeEnergy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ConsumeEnergy(ctx, req.(*ConsumeEnergyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_RefillEnergy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefillEnergyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).RefillEnergy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_RefillEnergy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).RefillEnergy(ctx, req.(*RefillEnergyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_GetEnergyConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEnergyConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetEnergyConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_GetEnergyConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetEnergyConfig(ctx, req.(*GetEnergyConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_UpdateEnergyConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEnergyConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).UpdateEnergyConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_UpdateEnergyConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).UpdateEnergyConfig(ctx, req.(*UpdateEnergyConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_SetEnergyLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEnergyLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SetEnergyLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_SetEnergyLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SetEnergyLevel(ctx, req.(*SetEnergyLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ResetEnergy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetEnergyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ResetEnergy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_ResetEnergy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ResetEnergy(ctx, req.(*ResetEnergyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_GrantItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GrantItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_GrantItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GrantItems(ctx, req.(*GrantItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_RevokeItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).RevokeItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_RevokeItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).RevokeItems(ctx, req.(*RevokeItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ListEnergyTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEnergyTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ListEnergyTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_ListEnergyTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ListEnergyTransactions(ctx, req.(*ListEnergyTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_GetNamespaceEnergyConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceEnergyConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetNamespaceEnergyConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_GetNamespaceEnergyConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetNamespaceEnergyConfig(ctx, req.(*GetNamespaceEnergyConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_UpdateNamespaceEnergyConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNamespaceEnergyConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).UpdateNamespaceEnergyConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_UpdateNamespaceEnergyConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).UpdateNamespaceEnergyConfig(ctx, req.(*UpdateNamespaceEnergyConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_CreateRegenBoost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRegenBoostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).CreateRegenBoost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_CreateRegenBoost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).CreateRegenBoost(ctx, req.(*CreateRegenBoostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ListRegenBoosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRegenBoostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ListRegenBoosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_ListRegenBoosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ListRegenBoosts(ctx, req.(*ListRegenBoostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_CancelRegenBoost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelRegenBoostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).CancelRegenBoost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_CancelRegenBoost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).CancelRegenBoost(ctx, req.(*CancelRegenBoostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_GetDebugTimeOffset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDebugTimeOffsetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetDebugTimeOffset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_GetDebugTimeOffset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetDebugTimeOffset(ctx, req.(*GetDebugTimeOffsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_SetDebugTimeOffset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDebugTimeOffsetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SetDebugTimeOffset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_SetDebugTimeOffset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SetDebugTimeOffset(ctx, req.(*SetDebugTimeOffsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Service_ServiceDesc is the grpc.ServiceDesc for Service service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
 var Service_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "service.Service",
 	HandlerType: (*ServiceServer)(nil),
@@ -483,6 +1311,10 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ConsumeMyEnergy",
 			Handler:    _Service_ConsumeMyEnergy_Handler,
 		},
+		{
+			MethodName: "ConsumeMyEnergyBatch",
+			Handler:    _Service_ConsumeMyEnergyBatch_Handler,
+		},
 		{
 			MethodName: "RefillMyEnergy",
 			Handler:    _Service_RefillMyEnergy_Handler,
@@ -491,10 +1323,54 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMyInventory",
 			Handler:    _Service_GetMyInventory_Handler,
 		},
+		{
+			MethodName: "SpendMyItems",
+			Handler:    _Service_SpendMyItems_Handler,
+		},
+		{
+			MethodName: "ClaimMyPendingItems",
+			Handler:    _Service_ClaimMyPendingItems_Handler,
+		},
 		{
 			MethodName: "GetMyEnergyConfig",
 			Handler:    _Service_GetMyEnergyConfig_Handler,
 		},
+		{
+			MethodName: "LevelUpMyEnergy",
+			Handler:    _Service_LevelUpMyEnergy_Handler,
+		},
+		{
+			MethodName: "ReserveEnergy",
+			Handler:    _Service_ReserveEnergy_Handler,
+		},
+		{
+			MethodName: "CommitEnergy",
+			Handler:    _Service_CommitEnergy_Handler,
+		},
+		{
+			MethodName: "ReleaseEnergy",
+			Handler:    _Service_ReleaseEnergy_Handler,
+		},
+		{
+			MethodName: "ListMyEnergyHistory",
+			Handler:    _Service_ListMyEnergyHistory_Handler,
+		},
+		{
+			MethodName: "ListItemCatalog",
+			Handler:    _Service_ListItemCatalog_Handler,
+		},
+		{
+			MethodName: "CraftItem",
+			Handler:    _Service_CraftItem_Handler,
+		},
+		{
+			MethodName: "ListRecipes",
+			Handler:    _Service_ListRecipes_Handler,
+		},
+		{
+			MethodName: "ExchangeForEnergy",
+			Handler:    _Service_ExchangeForEnergy_Handler,
+		},
 		{
 			MethodName: "GetEnergy",
 			Handler:    _Service_GetEnergy_Handler,
@@ -515,10 +1391,54 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateEnergyConfig",
 			Handler:    _Service_UpdateEnergyConfig_Handler,
 		},
+		{
+			MethodName: "SetEnergyLevel",
+			Handler:    _Service_SetEnergyLevel_Handler,
+		},
 		{
 			MethodName: "ResetEnergy",
 			Handler:    _Service_ResetEnergy_Handler,
 		},
+		{
+			MethodName: "GrantItems",
+			Handler:    _Service_GrantItems_Handler,
+		},
+		{
+			MethodName: "RevokeItems",
+			Handler:    _Service_RevokeItems_Handler,
+		},
+		{
+			MethodName: "ListEnergyTransactions",
+			Handler:    _Service_ListEnergyTransactions_Handler,
+		},
+		{
+			MethodName: "GetNamespaceEnergyConfig",
+			Handler:    _Service_GetNamespaceEnergyConfig_Handler,
+		},
+		{
+			MethodName: "UpdateNamespaceEnergyConfig",
+			Handler:    _Service_UpdateNamespaceEnergyConfig_Handler,
+		},
+		{
+			MethodName: "CreateRegenBoost",
+			Handler:    _Service_CreateRegenBoost_Handler,
+		},
+		{
+			MethodName: "ListRegenBoosts",
+			Handler:    _Service_ListRegenBoosts_Handler,
+		},
+		{
+			MethodName: "CancelRegenBoost",
+			Handler:    _Service_CancelRegenBoost_Handler,
+		},
+		{
+			MethodName: "GetDebugTimeOffset",
+			Handler:    _Service_GetDebugTimeOffset_Handler,
+		},
+		{
+			MethodName: "SetDebugTimeOffset",
+			Handler:    _Service_SetDebugTimeOffset_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
@@ -0,0 +1,108 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package purchase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclient/entitlement"
+	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclientmodels"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/platform"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PlatformVerifier verifies purchases against AGS Platform entitlements. The transaction ID
+// of a purchase refill is the ID of the consumable entitlement the purchase granted the player.
+// A transaction refills once, so only entitlements with a single use left are accepted.
+type PlatformVerifier struct {
+	entitlements *platform.EntitlementService
+}
+
+// NewPlatformVerifier creates a verifier using the AGS Platform entitlement service
+func NewPlatformVerifier(entitlements *platform.EntitlementService) *PlatformVerifier {
+	return &PlatformVerifier{entitlements: entitlements}
+}
+
+// VerifyPurchase checks the entitlement belongs to the player, is for itemId and has exactly one use left
+func (v *PlatformVerifier) VerifyPurchase(
+	ctx context.Context, namespace string, userId string, transactionId string, itemId string,
+) error {
+	input := &entitlement.GetUserEntitlementParams{
+		EntitlementID: transactionId,
+		Namespace:     namespace,
+		UserID:        userId,
+		Context:       ctx,
+	}
+
+	info, err := v.entitlements.GetUserEntitlementShort(input)
+	if err != nil {
+		var notFound *entitlement.GetUserEntitlementNotFound
+		if errors.As(err, &notFound) {
+			return status.Errorf(codes.NotFound, "Purchase %s not found", transactionId)
+		}
+
+		return status.Errorf(codes.Unavailable, "Error verifying purchase %s: %v", transactionId, err)
+	}
+
+	// The entitlement is looked up under the player, check it anyway
+	if info.UserID != userId {
+		return status.Errorf(codes.NotFound, "Purchase %s not found", transactionId)
+	}
+
+	var purchasedItemId, entitlementStatus string
+	if info.ItemID != nil {
+		purchasedItemId = *info.ItemID
+	}
+	if info.Status != nil {
+		entitlementStatus = *info.Status
+	}
+	usable := entitlementStatus == platformclientmodels.EntitlementInfoStatusACTIVE && info.UseCount > 0
+	if err := checkPurchase(transactionId, itemId, purchasedItemId, usable); err != nil {
+		return err
+	}
+
+	// Every use would refill with the same transaction ID, which replays the first refill,
+	// and consume with the same request ID, which replays the first consume
+	if info.UseCount != 1 {
+		return status.Errorf(codes.FailedPrecondition,
+			"Purchase %s has %d uses left, only single-use purchases refill energy", transactionId, info.UseCount)
+	}
+
+	return nil
+}
+
+// ConsumePurchase uses up one use of the entitlement. The transaction ID is sent as the request ID,
+// so consuming the same purchase again replays the original result.
+func (v *PlatformVerifier) ConsumePurchase(
+	ctx context.Context, namespace string, userId string, transactionId string,
+) error {
+	input := &entitlement.ConsumeUserEntitlementParams{
+		Body: &platformclientmodels.AdminEntitlementDecrement{
+			RequestID: transactionId,
+			UseCount:  1,
+		},
+		EntitlementID: transactionId,
+		Namespace:     namespace,
+		UserID:        userId,
+		Context:       ctx,
+	}
+
+	if _, err := v.entitlements.ConsumeUserEntitlementShort(input); err != nil {
+		var notFound *entitlement.ConsumeUserEntitlementNotFound
+		var conflict *entitlement.ConsumeUserEntitlementConflict
+		switch {
+		case errors.As(err, &notFound):
+			return status.Errorf(codes.NotFound, "Purchase %s not found", transactionId)
+		case errors.As(err, &conflict):
+			return status.Errorf(codes.FailedPrecondition, "Purchase %s was already used", transactionId)
+		}
+
+		return status.Errorf(codes.Unavailable, "Error consuming purchase %s: %v", transactionId, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package purchase
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Verifier confirms a purchase refill is backed by a real purchase. Errors are gRPC status
+// errors the service returns to the client as they are.
+type Verifier interface {
+	// VerifyPurchase checks transactionId is a purchase of itemId by the player that was not used yet
+	VerifyPurchase(ctx context.Context, namespace string, userId string, transactionId string, itemId string) error
+	// ConsumePurchase marks a verified purchase used. Consuming the same purchase again is a no-op.
+	ConsumePurchase(ctx context.Context, namespace string, userId string, transactionId string) error
+}
+
+// FakeVerifier is an in-memory Verifier for tests and local development
+type FakeVerifier struct {
+	mu        sync.Mutex
+	purchases map[string]*fakePurchase
+}
+
+type fakePurchase struct {
+	userId   string
+	itemId   string
+	consumed bool
+}
+
+// NewFakeVerifier creates a verifier without any purchases
+func NewFakeVerifier() *FakeVerifier {
+	return &FakeVerifier{purchases: make(map[string]*fakePurchase)}
+}
+
+// AddPurchase records that the player bought itemId in transaction transactionId
+func (v *FakeVerifier) AddPurchase(namespace string, userId string, transactionId string, itemId string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.purchases[namespace+"/"+transactionId] = &fakePurchase{userId: userId, itemId: itemId}
+}
+
+// Consumed reports whether the purchase was consumed
+func (v *FakeVerifier) Consumed(namespace string, transactionId string) bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	purchase, ok := v.purchases[namespace+"/"+transactionId]
+
+	return ok && purchase.consumed
+}
+
+// VerifyPurchase checks the purchase was added for the player and item and was not consumed
+func (v *FakeVerifier) VerifyPurchase(
+	_ context.Context, namespace string, userId string, transactionId string, itemId string,
+) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	purchase, ok := v.purchases[namespace+"/"+transactionId]
+	if !ok || purchase.userId != userId {
+		return status.Errorf(codes.NotFound, "Purchase %s not found", transactionId)
+	}
+
+	return checkPurchase(transactionId, itemId, purchase.itemId, !purchase.consumed)
+}
+
+// ConsumePurchase marks the purchase consumed
+func (v *FakeVerifier) ConsumePurchase(_ context.Context, namespace string, userId string, transactionId string) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	purchase, ok := v.purchases[namespace+"/"+transactionId]
+	if !ok || purchase.userId != userId {
+		return status.Errorf(codes.NotFound, "Purchase %s not found", transactionId)
+	}
+	purchase.consumed = true
+
+	return nil
+}
+
+// checkPurchase checks a purchase found for the player is for itemId and can still be used
+func checkPurchase(transactionId string, itemId string, purchasedItemId string, usable bool) error {
+	if purchasedItemId != itemId {
+		return status.Errorf(codes.InvalidArgument,
+			"Purchase %s is for item %s, not %s", transactionId, purchasedItemId, itemId)
+	}
+	if !usable {
+		return status.Errorf(codes.FailedPrecondition, "Purchase %s was already used", transactionId)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"extend-custom-guild-service/pkg/purchase"
+	"extend-custom-guild-service/pkg/storage"
+	"sync"
+	"time"
+)
+
+// Clock tells the energy service the current time
+type Clock interface {
+	Now() time.Time
+}
+
+// systemClock is the wall clock, used unless another clock is configured
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// FakeClock is a manually set clock for tests and regen simulations,
+// e.g. checking what a player sees after 3 hours offline
+type FakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFakeClock creates a clock standing still at now
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// Now returns the time the clock was set to
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+// Set moves the clock to now
+func (c *FakeClock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = now
+}
+
+// Advance moves the clock forward by d
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+// Option configures the energy service
+type Option func(*EnergyServiceServerImpl)
+
+// WithClock makes the service read the current time from clock instead of the wall clock
+func WithClock(clock Clock) Option {
+	return func(s *EnergyServiceServerImpl) {
+		s.clock = clock
+	}
+}
+
+// WithDebugTimeOffset lets admins move the clock of a namespace ahead with SetDebugTimeOffset.
+// Meant for test environments only, a namespace offset changes the time every player in it sees.
+func WithDebugTimeOffset() Option {
+	return func(s *EnergyServiceServerImpl) {
+		s.debugTimeOffsetEnabled = true
+	}
+}
+
+// WithPurchaseVerifier checks refills from purchase sources with verifier.
+// Without a verifier purchase refills are rejected.
+func WithPurchaseVerifier(verifier purchase.Verifier) Option {
+	return func(s *EnergyServiceServerImpl) {
+		s.purchases = verifier
+	}
+}
+
+// WithLedgerStore records every change of a player's energy or inventory in store.
+// Without a store nothing is recorded and the history RPCs are unavailable.
+func WithLedgerStore(store storage.LedgerStore) Option {
+	return func(s *EnergyServiceServerImpl) {
+		s.ledger = store
+	}
+}
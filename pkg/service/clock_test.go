@@ -0,0 +1,88 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "extend-custom-guild-service/pkg/pb"
+)
+
+func setDebugTimeOffset(offsetSeconds int64) regenStep {
+	return func(t *testing.T, s *EnergyServiceServerImpl, clock *FakeClock) {
+		resp, err := s.SetDebugTimeOffset(context.Background(), &pb.SetDebugTimeOffsetRequest{
+			Namespace: testNamespace, OffsetSeconds: offsetSeconds,
+		})
+		if err != nil {
+			t.Fatalf("set debug time offset: %v", err)
+		}
+		if want := clock.Now().Unix() + offsetSeconds; resp.EffectiveTime != want {
+			t.Fatalf("EffectiveTime = %d, want %d", resp.EffectiveTime, want)
+		}
+	}
+}
+
+func TestDebugTimeOffset(t *testing.T) {
+	tests := []struct {
+		name       string
+		steps      []regenStep
+		wantEnergy int32 // Energy the player sees after the last step
+	}{
+		{
+			name:       "offset fast-forwards regeneration",
+			steps:      []regenStep{fight(), setDebugTimeOffset(600)},
+			wantEnergy: 92,
+		},
+		{
+			name:       "offset and clock add up",
+			steps:      []regenStep{fight(), setDebugTimeOffset(600), advance(300 * time.Second)},
+			wantEnergy: 93,
+		},
+		{
+			name: "lowered offset does not regenerate the same time twice",
+			steps: []regenStep{
+				fight(), setDebugTimeOffset(600), fight(), // 82 at 600s
+				setDebugTimeOffset(0), advance(300 * time.Second), fight(), // 72, still at 600s
+				advance(600 * time.Second), // 900s, 300s after the last update
+			},
+			wantEnergy: 73,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _, clock := newTestServer(t, WithDebugTimeOffset())
+			for _, step := range tt.steps {
+				step(t, s, clock)
+			}
+
+			resp, err := s.GetMyEnergy(context.Background(), &pb.GetMyEnergyRequest{
+				Namespace: testNamespace, UserId: testUserId,
+			})
+			if err != nil {
+				t.Fatalf("get energy: %v", err)
+			}
+			if resp.EnergyState.CurrentEnergy != tt.wantEnergy {
+				t.Errorf("CurrentEnergy = %d, want %d", resp.EnergyState.CurrentEnergy, tt.wantEnergy)
+			}
+		})
+	}
+}
+
+func TestDebugTimeOffsetDisabled(t *testing.T) {
+	s, _, _ := newTestServer(t)
+
+	_, err := s.SetDebugTimeOffset(context.Background(), &pb.SetDebugTimeOffsetRequest{
+		Namespace: testNamespace, OffsetSeconds: 600,
+	})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("SetDebugTimeOffset error = %v, want FailedPrecondition", err)
+	}
+}
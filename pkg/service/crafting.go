@@ -0,0 +1,117 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"extend-custom-guild-service/pkg/economy"
+	pb "extend-custom-guild-service/pkg/pb"
+	"extend-custom-guild-service/pkg/storage"
+	"fmt"
+	"math/rand"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// craftResult is the outcome of crafting a recipe
+type craftResult struct {
+	success    bool // Inputs and energy were spent
+	crafted    bool // Outputs were granted
+	message    string
+	outputs    []*pb.LootItem
+	shortfalls []*pb.ItemShortfall
+	shortState *pb.EnergyState // First pool short of energy
+}
+
+// craftRecipe spends the recipe's inputs and energy, then rolls its success chance and grants the outputs.
+// Nothing is changed on data when the player is short of an input or energy, or the outputs
+// would not fit in the inventory once the inputs are spent.
+func craftRecipe(
+	config *economy.Config, data *storage.EnergyData, recipeId string, recipe economy.Recipe, now int64,
+) (craftResult, error) {
+	if !recipe.Unlocked(data.Level) {
+		return craftResult{}, status.Errorf(codes.FailedPrecondition,
+			"Recipe %s unlocks at energy level %d", recipeId, recipe.UnlockLevel)
+	}
+
+	if shortfalls := itemShortfalls(data, recipe.Inputs); len(shortfalls) > 0 {
+		return craftResult{message: shortfallMessage(shortfalls), shortfalls: shortfalls}, nil
+	}
+	if err := checkOutputRoom(config, data, recipe); err != nil {
+		return craftResult{message: status.Convert(err).Message()}, nil
+	}
+	if shortState, enough := consumePoolAmounts(config, data, recipe.EnergyCost, now); !enough {
+		return craftResult{
+			message: fmt.Sprintf("Insufficient %s. Required: %d, Available: %d",
+				shortState.PoolId, recipe.EnergyCost[shortState.PoolId], shortState.CurrentEnergy),
+			shortState: shortState,
+		}, nil
+	}
+	spendItems(data, recipe.Inputs)
+
+	// Rolled again on retry so it always matches the saved inventory
+	if rand.Int31n(100) >= recipe.SuccessChance() {
+		return craftResult{success: true, message: fmt.Sprintf("Crafting %s failed", recipeId)}, nil
+	}
+
+	outputs := grantLoot(config, data, toLootItems(config, recipe.Outputs, ""))
+
+	return craftResult{
+		success: true,
+		crafted: true,
+		message: fmt.Sprintf("Crafted %s", formatItemQuantities(recipe.Outputs)),
+		outputs: outputs,
+	}, nil
+}
+
+// checkOutputRoom checks the recipe's outputs fit in the inventory after its inputs are spent,
+// which may free their stacks and slots. Outputs that do not fit are never discarded or converted.
+func checkOutputRoom(config *economy.Config, data *storage.EnergyData, recipe economy.Recipe) error {
+	inventory := make(map[string]int32, len(data.Inventory))
+	for itemId, qty := range data.Inventory {
+		if qty -= recipe.Inputs[itemId]; qty > 0 {
+			inventory[itemId] = qty
+		}
+	}
+
+	return checkItemRoom(config, inventory, recipe.Outputs)
+}
+
+// toLootItems converts item quantities to their API representation in item ID order
+func toLootItems(config *economy.Config, quantities map[string]int32, language string) []*pb.LootItem {
+	items := make([]*pb.LootItem, 0, len(quantities))
+	for _, itemId := range sortedItemIds(quantities) {
+		items = append(items, &pb.LootItem{
+			ItemId:   itemId,
+			ItemName: config.LocalizedItemName(itemId, language),
+			Quantity: quantities[itemId],
+		})
+	}
+
+	return items
+}
+
+// toRecipe converts a recipe to its API representation with whether the player can craft it now
+func toRecipe(
+	config *economy.Config, data *storage.EnergyData, recipeId string, recipe economy.Recipe, now int64, language string,
+) *pb.Recipe {
+	shortfalls := itemShortfalls(data, recipe.Inputs)
+	unlocked := recipe.Unlocked(data.Level)
+	canCraft := unlocked && len(shortfalls) == 0 && shortPool(config, data, recipe.EnergyCost, now) == nil &&
+		checkOutputRoom(config, data, recipe) == nil
+
+	return &pb.Recipe{
+		RecipeId:       recipeId,
+		Name:           recipe.Name,
+		Inputs:         toLootItems(config, recipe.Inputs, language),
+		Outputs:        toLootItems(config, recipe.Outputs, language),
+		EnergyCosts:    recipe.EnergyCost,
+		SuccessPercent: recipe.SuccessChance(),
+		UnlockLevel:    recipe.UnlockLevel,
+		Unlocked:       unlocked,
+		CanCraft:       canCraft,
+		Shortfalls:     shortfalls,
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	pb "extend-custom-guild-service/pkg/pb"
+)
+
+// Brewing a healing potion takes 3 herbs, 10 gold and 2 energy and always succeeds
+func TestCraftOutputRoom(t *testing.T) {
+	tests := []struct {
+		name        string
+		maxSlots    int32
+		potionStack int32
+		inventory   map[string]int32
+		wantCrafted bool
+	}{
+		{
+			name:        "output over its max stack",
+			potionStack: 1,
+			inventory:   map[string]int32{"herb": 3, "gold": 10, "healing_potion": 1},
+		},
+		{
+			name:      "no free slot for the output",
+			maxSlots:  3,
+			inventory: map[string]int32{"herb": 4, "gold": 11, "iron_ore": 1},
+		},
+		{
+			name:        "spent input frees a slot for the output",
+			maxSlots:    3,
+			inventory:   map[string]int32{"herb": 3, "gold": 10, "iron_ore": 1},
+			wantCrafted: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := loadTestConfig(t)
+			config.Inventory.MaxSlots = tt.maxSlots
+			if tt.potionStack > 0 {
+				potion := config.Items["healing_potion"]
+				potion.MaxStack = tt.potionStack
+				config.Items["healing_potion"] = potion
+			}
+			s, store, _ := newTestServerWithConfig(t, config)
+
+			var items []*pb.ItemQuantity
+			for itemId, qty := range tt.inventory {
+				items = append(items, &pb.ItemQuantity{ItemId: itemId, Quantity: qty})
+			}
+			if _, err := s.GrantItems(context.Background(), &pb.GrantItemsRequest{
+				Namespace: testNamespace, UserId: testUserId, Items: items,
+			}); err != nil {
+				t.Fatalf("grant items: %v", err)
+			}
+
+			resp, err := s.CraftItem(context.Background(), &pb.CraftItemRequest{
+				Namespace: testNamespace, UserId: testUserId, RecipeId: "healing_potion",
+			})
+			if err != nil {
+				t.Fatalf("craft item: %v", err)
+			}
+			if resp.Success != tt.wantCrafted || resp.Crafted != tt.wantCrafted {
+				t.Fatalf("Success, Crafted = %v, %v, want %v (%s)", resp.Success, resp.Crafted, tt.wantCrafted, resp.Message)
+			}
+
+			data, err := store.GetEnergyData(context.Background(), testNamespace, testUserId)
+			if err != nil {
+				t.Fatalf("get energy data: %v", err)
+			}
+			if tt.wantCrafted {
+				if data.Inventory["healing_potion"] != 1 || data.CurrentEnergy != 98 {
+					t.Errorf("healing_potion, energy = %d, %d, want 1, 98", data.Inventory["healing_potion"], data.CurrentEnergy)
+				}
+				return
+			}
+
+			// Nothing is spent when the outputs would not fit
+			if !reflect.DeepEqual(data.Inventory, tt.inventory) || data.CurrentEnergy != 100 {
+				t.Errorf("inventory, energy = %v, %d, want %v, 100", data.Inventory, data.CurrentEnergy, tt.inventory)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"extend-custom-guild-service/pkg/economy"
+	pb "extend-custom-guild-service/pkg/pb"
+	"extend-custom-guild-service/pkg/storage"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Most actions one batch may perform, counting every repetition
+const maxBatchActions = 100
+
+// validateBatch checks every action is configured and the batch is not too large
+func validateBatch(config *economy.Config, actions []*pb.BatchAction) error {
+	if len(actions) == 0 {
+		return status.Errorf(codes.InvalidArgument, "At least one action is required")
+	}
+
+	var total int32
+	for _, action := range actions {
+		if _, validAction := config.ActionCosts[action.ActionType]; !validAction {
+			return status.Errorf(codes.InvalidArgument, "Invalid action type: %s", action.ActionType)
+		}
+		if action.Count <= 0 {
+			return status.Errorf(codes.InvalidArgument, "Count of %s must be positive", action.ActionType)
+		}
+
+		total += action.Count
+		if total > maxBatchActions {
+			return status.Errorf(codes.InvalidArgument, "A batch may perform at most %d actions", maxBatchActions)
+		}
+	}
+
+	return nil
+}
+
+// consumeBatch performs the actions on the player's regenerated energy and rolls their loot.
+// Nothing is changed on data when no action could be performed.
+func consumeBatch(
+	config *economy.Config, data *storage.EnergyData, actions []*pb.BatchAction, mode pb.BatchMode, now int64,
+) *pb.ConsumeEnergyBatchResponse {
+	results := make([]*pb.BatchActionResult, len(actions))
+	costs := make([]economy.PoolAmounts, len(actions))
+	total := economy.PoolAmounts{}
+	var requested, performed int32
+	var shortState *pb.EnergyState
+
+	for i, action := range actions {
+		// The player's energy level may discount the cost
+		costs[i], _ = config.ActionCost(action.ActionType, data.Level)
+		results[i] = &pb.BatchActionResult{
+			ActionType: action.ActionType,
+			Requested:  action.Count,
+			Costs:      map[string]int32{},
+		}
+		requested += action.Count
+	}
+
+	if mode == pb.BatchMode_ALL_OR_NOTHING {
+		for i, action := range actions {
+			addPoolAmounts(total, costs[i], action.Count)
+		}
+
+		var enough bool
+		if shortState, enough = consumePoolAmounts(config, data, total, now); enough {
+			for i, action := range actions {
+				results[i].Performed = action.Count
+				addPoolAmounts(results[i].Costs, costs[i], action.Count)
+			}
+			performed = requested
+		}
+	} else {
+		// Keep going with later actions when one runs out, they may cost other pools or less
+		for i, action := range actions {
+			for results[i].Performed < action.Count {
+				var enough bool
+				if shortState, enough = consumePoolAmounts(config, data, costs[i], now); !enough {
+					break
+				}
+				results[i].Performed++
+				addPoolAmounts(results[i].Costs, costs[i], 1)
+				addPoolAmounts(total, costs[i], 1)
+			}
+			performed += results[i].Performed
+		}
+	}
+
+	if performed == 0 {
+		message := fmt.Sprintf("Insufficient %s for any action. Available: %d",
+			shortState.PoolId, shortState.CurrentEnergy)
+		if mode == pb.BatchMode_ALL_OR_NOTHING {
+			message = fmt.Sprintf("Insufficient %s. Required: %d, Available: %d",
+				shortState.PoolId, total[shortState.PoolId], shortState.CurrentEnergy)
+		}
+
+		return &pb.ConsumeEnergyBatchResponse{
+			EnergyState: shortState,
+			Success:     false,
+			Message:     message,
+			Results:     results,
+		}
+	}
+
+	// Roll loot per action performed (re-rolled on retry so it always matches the saved inventory).
+	// Only the merged loot is marked with what was granted.
+	var loot []*pb.LootItem
+	for _, result := range results {
+		for n := int32(0); n < result.Performed; n++ {
+			rolled := rollLoot(config, result.ActionType)
+			result.Loot = mergeLoot(result.Loot, rolled)
+			loot = mergeLoot(loot, rolled)
+		}
+	}
+	loot = grantLoot(config, data, loot)
+
+	pools := calculatePoolStates(config, data, total.PoolIDs(), now)
+
+	return &pb.ConsumeEnergyBatchResponse{
+		EnergyState: pools[0],
+		Success:     true,
+		Message:     fmt.Sprintf("Consumed %s for %d of %d actions", formatPoolAmounts(total), performed, requested),
+		Loot:        loot,
+		Pools:       pools,
+		Results:     results,
+	}
+}
+
+// batchActionTypes lists the action types of a batch for its ledger entry
+func batchActionTypes(actions []*pb.BatchAction) string {
+	actionTypes := make([]string, len(actions))
+	for i, action := range actions {
+		actionTypes[i] = action.ActionType
+	}
+
+	return strings.Join(actionTypes, ",")
+}
+
+// addPoolAmounts adds amounts count times to total
+func addPoolAmounts(total map[string]int32, amounts economy.PoolAmounts, count int32) {
+	for poolId, amount := range amounts {
+		total[poolId] += amount * count
+	}
+}
+
+// mergeLoot adds loot to merged, combining the quantities of the same item
+func mergeLoot(merged []*pb.LootItem, loot []*pb.LootItem) []*pb.LootItem {
+	for _, item := range loot {
+		found := false
+		for _, existing := range merged {
+			if existing.ItemId == item.ItemId {
+				existing.Quantity += item.Quantity
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			merged = append(merged, &pb.LootItem{
+				ItemId:   item.ItemId,
+				ItemName: item.ItemName,
+				Quantity: item.Quantity,
+			})
+		}
+	}
+
+	return merged
+}
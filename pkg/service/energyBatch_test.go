@@ -0,0 +1,107 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "extend-custom-guild-service/pkg/pb"
+)
+
+func consumeBatchRequest(mode pb.BatchMode, actions ...*pb.BatchAction) *pb.ConsumeMyEnergyBatchRequest {
+	return &pb.ConsumeMyEnergyBatchRequest{
+		Namespace: testNamespace, UserId: testUserId, Actions: actions, Mode: mode,
+	}
+}
+
+// A fight costs 10 energy, a pvp match 5 energy and a ticket. The player starts with
+// 100 energy and 5 tickets, so the sixth pvp match is short of a ticket.
+func TestConsumeBatch(t *testing.T) {
+	tests := []struct {
+		name          string
+		mode          pb.BatchMode
+		wantSuccess   bool
+		wantPerformed []int32
+		wantEnergy    int32
+		wantTickets   int32
+	}{
+		{
+			name:          "all or nothing leaves every pool unchanged",
+			mode:          pb.BatchMode_ALL_OR_NOTHING,
+			wantPerformed: []int32{0, 0},
+			wantEnergy:    100,
+			wantTickets:   5,
+		},
+		{
+			name:          "best effort performs what every pool can pay",
+			mode:          pb.BatchMode_BEST_EFFORT,
+			wantSuccess:   true,
+			wantPerformed: []int32{5, 2},
+			wantEnergy:    55,
+			wantTickets:   0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _, _ := newTestServer(t)
+
+			resp, err := s.ConsumeMyEnergyBatch(context.Background(), consumeBatchRequest(tt.mode,
+				&pb.BatchAction{ActionType: "pvp", Count: 6},
+				&pb.BatchAction{ActionType: "fight", Count: 2},
+			))
+			if err != nil {
+				t.Fatalf("consume batch: %v", err)
+			}
+			if resp.Success != tt.wantSuccess {
+				t.Errorf("Success = %v, want %v (%s)", resp.Success, tt.wantSuccess, resp.Message)
+			}
+			if len(resp.Results) != len(tt.wantPerformed) {
+				t.Fatalf("len(Results) = %d, want %d", len(resp.Results), len(tt.wantPerformed))
+			}
+			for i, result := range resp.Results {
+				if result.Performed != tt.wantPerformed[i] {
+					t.Errorf("%s performed %d, want %d", result.ActionType, result.Performed, tt.wantPerformed[i])
+				}
+			}
+
+			pools := currentPools(t, s)
+			if pools["energy"] != tt.wantEnergy || pools["tickets"] != tt.wantTickets {
+				t.Errorf("energy, tickets = %d, %d, want %d, %d",
+					pools["energy"], pools["tickets"], tt.wantEnergy, tt.wantTickets)
+			}
+		})
+	}
+}
+
+func TestConsumeBatchSpendsExpiredHolds(t *testing.T) {
+	s, store, clock := newTestServer(t)
+
+	// 85 energy is left, the batch needs the 15 held for the dungeon
+	reserve(t, s, "dungeon", time.Minute)
+	clock.Advance(time.Minute)
+
+	resp, err := s.ConsumeMyEnergyBatch(context.Background(), consumeBatchRequest(pb.BatchMode_ALL_OR_NOTHING,
+		&pb.BatchAction{ActionType: "fight", Count: 10},
+	))
+	if err != nil || !resp.Success {
+		t.Fatalf("consume batch: %v %v", resp, err)
+	}
+
+	pools := currentPools(t, s)
+	if pools["energy"] != 0 || pools["keys"] != 3 {
+		t.Errorf("energy, keys = %d, %d, want 0, 3", pools["energy"], pools["keys"])
+	}
+
+	data, err := store.GetEnergyData(context.Background(), testNamespace, testUserId)
+	if err != nil {
+		t.Fatalf("get energy data: %v", err)
+	}
+	if len(data.Holds) > 0 {
+		t.Errorf("expired hold was kept: %v", data.Holds)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"extend-custom-guild-service/pkg/economy"
+	pb "extend-custom-guild-service/pkg/pb"
+	"extend-custom-guild-service/pkg/storage"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// How long a hold lasts when the client does not ask for a TTL
+	defaultHoldTTL = 5 * time.Minute
+	// Longest TTL a client may ask for
+	maxHoldTTL = time.Hour
+	// Open holds a player may have at once
+	maxEnergyHolds = 10
+)
+
+// holdTTL returns the TTL for a new hold, ttlSeconds 0 meaning the default
+func holdTTL(ttlSeconds int64) (time.Duration, error) {
+	if ttlSeconds == 0 {
+		return defaultHoldTTL, nil
+	}
+
+	ttl := time.Duration(ttlSeconds) * time.Second
+	if ttlSeconds < 0 || ttl > maxHoldTTL {
+		return 0, status.Errorf(codes.InvalidArgument,
+			"TTL must be between 1 and %d seconds", int64(maxHoldTTL.Seconds()))
+	}
+
+	return ttl, nil
+}
+
+// findHold returns the index of a hold of the player
+func findHold(data *storage.EnergyData, holdId string) (int, error) {
+	for i, hold := range data.Holds {
+		if hold.HoldId == holdId {
+			return i, nil
+		}
+	}
+
+	return -1, status.Errorf(codes.NotFound, "Hold %s not found, it may have expired and been refunded", holdId)
+}
+
+// removeHold removes the hold at index from the player's holds
+func removeHold(data *storage.EnergyData, index int) {
+	data.Holds = append(data.Holds[:index], data.Holds[index+1:]...)
+	if len(data.Holds) == 0 {
+		data.Holds = nil
+	}
+}
+
+// releaseHold refunds the hold at index and removes it.
+// A refund never raises a pool above its max energy, energy already above it is kept.
+func releaseHold(config *economy.Config, data *storage.EnergyData, index int, now int64) {
+	for poolId, cost := range data.Holds[index].Costs {
+		// The pool may have been removed from the economy config since the hold was made
+		if !config.HasPool(poolId) {
+			continue
+		}
+
+		maxEnergy, _ := poolLimits(config, data, poolId)
+		refillPoolEnergy(config, data, poolId, cost, maxEnergy, now)
+	}
+
+	removeHold(data, index)
+}
+
+// hasExpiredHolds reports whether any of the player's holds expired, now being Unix milliseconds
+func hasExpiredHolds(data *storage.EnergyData, now int64) bool {
+	for _, hold := range data.Holds {
+		if hold.ExpiresAt*1000 <= now {
+			return true
+		}
+	}
+
+	return false
+}
+
+// releaseExpiredHolds refunds every expired hold, their action was never resolved
+func releaseExpiredHolds(config *economy.Config, data *storage.EnergyData, now int64) {
+	for i := len(data.Holds) - 1; i >= 0; i-- {
+		if data.Holds[i].ExpiresAt*1000 <= now {
+			releaseHold(config, data, i, now)
+		}
+	}
+}
+
+// releaseAllHolds refunds every open hold and returns their IDs
+func releaseAllHolds(config *economy.Config, data *storage.EnergyData, now int64) []string {
+	var holdIds []string
+	for len(data.Holds) > 0 {
+		holdIds = append(holdIds, data.Holds[0].HoldId)
+		releaseHold(config, data, 0, now)
+	}
+
+	return holdIds
+}
+
+// toEnergyHold converts a stored hold to its API representation
+func toEnergyHold(hold storage.EnergyHold) *pb.EnergyHold {
+	return &pb.EnergyHold{
+		HoldId:     hold.HoldId,
+		ActionType: hold.ActionType,
+		Costs:      hold.Costs,
+		CreatedAt:  hold.CreatedAt,
+		ExpiresAt:  hold.ExpiresAt,
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "extend-custom-guild-service/pkg/pb"
+)
+
+// currentPools returns the energy the player sees in every pool
+func currentPools(t *testing.T, s *EnergyServiceServerImpl) map[string]int32 {
+	t.Helper()
+
+	resp, err := s.GetMyEnergy(context.Background(), &pb.GetMyEnergyRequest{Namespace: testNamespace, UserId: testUserId})
+	if err != nil {
+		t.Fatalf("get energy: %v", err)
+	}
+
+	pools := make(map[string]int32, len(resp.Pools))
+	for _, pool := range resp.Pools {
+		pools[pool.PoolId] = pool.CurrentEnergy
+	}
+
+	return pools
+}
+
+// reserve holds the cost of an action for ttl, 0 meaning the default TTL
+func reserve(t *testing.T, s *EnergyServiceServerImpl, actionType string, ttl time.Duration) string {
+	t.Helper()
+
+	resp, err := s.ReserveEnergy(context.Background(), &pb.ReserveEnergyRequest{
+		Namespace: testNamespace, UserId: testUserId, ActionType: actionType, TtlSeconds: int64(ttl / time.Second),
+	})
+	if err != nil || !resp.Success {
+		t.Fatalf("reserve %s: %v %v", actionType, resp, err)
+	}
+
+	return resp.Hold.HoldId
+}
+
+func commitMyHold(s *EnergyServiceServerImpl, holdId string) error {
+	_, err := s.CommitEnergy(context.Background(), &pb.CommitEnergyRequest{
+		Namespace: testNamespace, UserId: testUserId, HoldId: holdId,
+	})
+
+	return err
+}
+
+func releaseMyHold(s *EnergyServiceServerImpl, holdId string) error {
+	_, err := s.ReleaseEnergy(context.Background(), &pb.ReleaseEnergyRequest{
+		Namespace: testNamespace, UserId: testUserId, HoldId: holdId,
+	})
+
+	return err
+}
+
+// A dungeon costs 15 energy and a key, the player starts with 100 energy and 3 keys
+func TestEnergyHolds(t *testing.T) {
+	tests := []struct {
+		name    string
+		resolve func(s *EnergyServiceServerImpl, clock *FakeClock, holdId string) error
+		// State after the hold is resolved
+		wantEnergy int32
+		wantKeys   int32
+		wantOpen   bool
+	}{
+		{
+			name:       "open hold keeps the cost deducted",
+			resolve:    func(_ *EnergyServiceServerImpl, _ *FakeClock, _ string) error { return nil },
+			wantEnergy: 85,
+			wantKeys:   2,
+			wantOpen:   true,
+		},
+		{
+			name: "commit keeps the cost spent",
+			resolve: func(s *EnergyServiceServerImpl, _ *FakeClock, holdId string) error {
+				return commitMyHold(s, holdId)
+			},
+			wantEnergy: 85,
+			wantKeys:   2,
+		},
+		{
+			name: "release refunds every pool",
+			resolve: func(s *EnergyServiceServerImpl, _ *FakeClock, holdId string) error {
+				return releaseMyHold(s, holdId)
+			},
+			wantEnergy: 100,
+			wantKeys:   3,
+		},
+		{
+			name: "expired hold is refunded",
+			resolve: func(_ *EnergyServiceServerImpl, clock *FakeClock, _ string) error {
+				clock.Advance(time.Minute)
+				return nil
+			},
+			wantEnergy: 100,
+			wantKeys:   3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, store, clock := newTestServer(t)
+
+			holdId := reserve(t, s, "dungeon", time.Minute)
+			if err := tt.resolve(s, clock, holdId); err != nil {
+				t.Fatalf("resolve hold: %v", err)
+			}
+
+			pools := currentPools(t, s)
+			if pools["energy"] != tt.wantEnergy || pools["keys"] != tt.wantKeys {
+				t.Errorf("energy, keys = %d, %d, want %d, %d", pools["energy"], pools["keys"], tt.wantEnergy, tt.wantKeys)
+			}
+
+			data, err := store.GetEnergyData(context.Background(), testNamespace, testUserId)
+			if err != nil {
+				t.Fatalf("get energy data: %v", err)
+			}
+			if open := len(data.Holds) > 0; open != tt.wantOpen {
+				t.Errorf("stored holds = %v, want open %v", data.Holds, tt.wantOpen)
+			}
+		})
+	}
+}
+
+func TestResolvedHoldCannotBeResolvedAgain(t *testing.T) {
+	tests := []struct {
+		name       string
+		resolve    func(s *EnergyServiceServerImpl, clock *FakeClock, holdId string) error
+		wantEnergy int32
+	}{
+		{
+			name: "committed",
+			resolve: func(s *EnergyServiceServerImpl, _ *FakeClock, holdId string) error {
+				return commitMyHold(s, holdId)
+			},
+			wantEnergy: 90,
+		},
+		{
+			name: "released",
+			resolve: func(s *EnergyServiceServerImpl, _ *FakeClock, holdId string) error {
+				return releaseMyHold(s, holdId)
+			},
+			wantEnergy: 100,
+		},
+		{
+			name: "expired",
+			resolve: func(_ *EnergyServiceServerImpl, clock *FakeClock, _ string) error {
+				clock.Advance(time.Minute)
+				return nil
+			},
+			wantEnergy: 100,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _, clock := newTestServer(t)
+
+			holdId := reserve(t, s, "fight", time.Minute)
+			if err := tt.resolve(s, clock, holdId); err != nil {
+				t.Fatalf("resolve hold: %v", err)
+			}
+
+			if err := commitMyHold(s, holdId); status.Code(err) != codes.NotFound {
+				t.Errorf("commit error = %v, want NotFound", err)
+			}
+			if err := releaseMyHold(s, holdId); status.Code(err) != codes.NotFound {
+				t.Errorf("release error = %v, want NotFound", err)
+			}
+			if energy := currentPools(t, s)["energy"]; energy != tt.wantEnergy {
+				t.Errorf("energy = %d, want %d", energy, tt.wantEnergy)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"extend-custom-guild-service/pkg/economy"
+	pb "extend-custom-guild-service/pkg/pb"
+	"extend-custom-guild-service/pkg/storage"
+)
+
+// energyLimits returns the max energy and regen rate in effect for a player: the per-user
+// override if set, else the level's values, else the stored level 1 values
+func energyLimits(config *economy.Config, data *storage.EnergyData) (maxEnergy int32, regenRateSeconds int32) {
+	maxEnergy, regenRateSeconds = data.MaxEnergy, data.RegenRateSeconds
+	if level, ok := config.Level(data.Level); ok {
+		maxEnergy, regenRateSeconds = level.MaxEnergy, level.RegenRateSeconds
+	}
+
+	if data.MaxEnergyOverride > 0 {
+		maxEnergy = data.MaxEnergyOverride
+	}
+	if data.RegenRateSecondsOverride > 0 {
+		regenRateSeconds = data.RegenRateSecondsOverride
+	}
+
+	return maxEnergy, regenRateSeconds
+}
+
+// toEnergyConfig converts stored data to its API representation with the limits in effect
+func toEnergyConfig(config *economy.Config, data *storage.EnergyData) *pb.EnergyConfig {
+	maxEnergy, regenRateSeconds := energyLimits(config, data)
+
+	return &pb.EnergyConfig{
+		UserId:              data.UserId,
+		MaxEnergy:           maxEnergy,
+		RegenRateSeconds:    regenRateSeconds,
+		Level:               data.Level,
+		MaxEnergyOverridden: data.MaxEnergyOverride > 0,
+		RegenRateOverridden: data.RegenRateSecondsOverride > 0,
+	}
+}
+
+// setEnergyLevel banks regenerated energy at the old limits, then moves the player to level.
+// Energy above the new max energy is kept as overflow.
+func setEnergyLevel(config *economy.Config, data *storage.EnergyData, level int32, now int64) {
+	changeEnergyLimits(config, data, now, func() {
+		data.Level = level
+	})
+}
+
+// spendUpgradeCost removes the items required for level from the inventory.
+// Nothing is removed and a description of the shortfall is returned if any item is missing.
+func spendUpgradeCost(data *storage.EnergyData, level *economy.Level) string {
+	if shortfalls := spendItems(data, level.UpgradeCost); len(shortfalls) > 0 {
+		return shortfallMessage(shortfalls)
+	}
+
+	return ""
+}
@@ -0,0 +1,225 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"extend-custom-guild-service/pkg/economy"
+	pb "extend-custom-guild-service/pkg/pb"
+	"extend-custom-guild-service/pkg/storage"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// poolIdOrDefault returns poolId, or the default pool when the client did not pick one
+func poolIdOrDefault(poolId string) string {
+	if poolId == "" {
+		return economy.DefaultPoolID
+	}
+
+	return poolId
+}
+
+// validatePool returns an InvalidArgument error when the pool is not configured
+func validatePool(config *economy.Config, poolId string) error {
+	if !config.HasPool(poolId) {
+		return status.Errorf(codes.InvalidArgument, "Invalid energy pool: %s", poolId)
+	}
+
+	return nil
+}
+
+// poolLimits returns the max energy and regen rate of a pool for the player
+func poolLimits(config *economy.Config, data *storage.EnergyData, poolId string) (int32, int32) {
+	if poolId == economy.DefaultPoolID {
+		return energyLimits(config, data)
+	}
+
+	pool := config.Pools[poolId]
+
+	return pool.MaxEnergy, pool.RegenRateSeconds
+}
+
+// getPoolEnergy returns the stored energy, last update time in Unix milliseconds and progress towards
+// the next point of a pool. A pool the player has not used yet starts at its starting energy.
+func getPoolEnergy(config *economy.Config, data *storage.EnergyData, poolId string, now int64) (int32, int64, int64) {
+	if poolId == economy.DefaultPoolID {
+		return data.CurrentEnergy, lastUpdateMillis(data.LastUpdateTime, data.LastUpdateTimeMs), data.RegenProgress
+	}
+
+	if pool, ok := data.Pools[poolId]; ok {
+		return pool.CurrentEnergy, lastUpdateMillis(pool.LastUpdateTime, pool.LastUpdateTimeMs), pool.RegenProgress
+	}
+
+	return config.Pools[poolId].StartingEnergy, now, 0
+}
+
+// lastUpdateMillis returns the last update time in Unix milliseconds, falling back to
+// the whole seconds of records saved before sub-second accuracy
+func lastUpdateMillis(seconds int64, millis int64) int64 {
+	if millis > 0 {
+		return millis
+	}
+
+	return seconds * 1000
+}
+
+// setPoolEnergy stores the energy, last update time and progress towards the next point of a pool
+func setPoolEnergy(data *storage.EnergyData, poolId string, energy int32, now int64, progress int64) {
+	if poolId == economy.DefaultPoolID {
+		data.CurrentEnergy = energy
+		data.LastUpdateTime = now / 1000
+		data.LastUpdateTimeMs = now
+		data.RegenProgress = progress
+
+		return
+	}
+
+	if data.Pools == nil {
+		data.Pools = make(map[string]storage.PoolData)
+	}
+	data.Pools[poolId] = storage.PoolData{
+		CurrentEnergy:    energy,
+		LastUpdateTime:   now / 1000,
+		LastUpdateTimeMs: now,
+		RegenProgress:    progress,
+	}
+}
+
+// calculatePoolState returns the regenerated state of a pool at the player's limits, now being
+// Unix milliseconds. Regen boosts are integrated piecewise, so the next regen and full times stay
+// exact across boost boundaries. Overflow energy above max is kept as is, no regeneration happens
+// while the pool is at or above max.
+func calculatePoolState(config *economy.Config, data *storage.EnergyData, poolId string, now int64) *pb.EnergyState {
+	regen := regeneratePool(config, data, poolId, now)
+	unit := regenUnit(regen.regenRateSeconds)
+
+	// Calculate time to next regen and time to max
+	energyToMax := regen.maxEnergy - regen.energy
+	var overflowEnergy int32
+	if energyToMax < 0 {
+		overflowEnergy, energyToMax = -energyToMax, 0
+	}
+	var nextRegenTime int64 = 0
+	var timeToMax int64 = 0
+
+	if energyToMax > 0 && unit > 0 {
+		// Time until next energy point, minus the progress already made towards it
+		nextRegenTime = regen.schedule.advance(now, unit-regen.progress)
+
+		// Total time to reach max, minus the progress already made
+		timeToMax = regen.schedule.advance(now, int64(energyToMax)*unit-regen.progress) - now
+	}
+
+	return &pb.EnergyState{
+		UserId:                 data.UserId,
+		CurrentEnergy:          regen.energy,
+		MaxEnergy:              regen.maxEnergy,
+		LastUpdateTime:         regen.lastUpdateTime / 1000,
+		RegenRateSeconds:       regen.regenRateSeconds,
+		NextRegenTime:          ceilSeconds(nextRegenTime),
+		EnergyToMax:            energyToMax,
+		TimeToMaxSeconds:       ceilSeconds(timeToMax),
+		PoolId:                 poolId,
+		OverflowEnergy:         overflowEnergy,
+		RegenMultiplierPercent: int32(regen.schedule.multiplierAt(now)),
+		NextRegenTimeMs:        nextRegenTime,
+		TimeToMaxMs:            timeToMax,
+	}
+}
+
+// calculatePoolStates returns the regenerated state of each of the pools
+func calculatePoolStates(
+	config *economy.Config, data *storage.EnergyData, poolIds []string, now int64,
+) []*pb.EnergyState {
+	states := make([]*pb.EnergyState, 0, len(poolIds))
+	for _, poolId := range poolIds {
+		states = append(states, calculatePoolState(config, data, poolId, now))
+	}
+
+	return states
+}
+
+// consumePoolEnergy deducts amount from a pool's regenerated energy, the pool must have enough
+func consumePoolEnergy(config *economy.Config, data *storage.EnergyData, poolId string, amount int32, now int64) {
+	regen := regeneratePool(config, data, poolId, now)
+	storePoolEnergy(config, data, poolId, regen.energy-amount, regen.progress, now)
+}
+
+// shortPool returns the state of the first pool with less regenerated energy than amounts,
+// or nil when every pool has enough
+func shortPool(config *economy.Config, data *storage.EnergyData, amounts economy.PoolAmounts, now int64) *pb.EnergyState {
+	for _, poolState := range calculatePoolStates(config, data, amounts.PoolIDs(), now) {
+		if poolState.CurrentEnergy < amounts[poolState.PoolId] {
+			return poolState
+		}
+	}
+
+	return nil
+}
+
+// consumePoolAmounts deducts amounts from the pools when every pool has enough regenerated energy.
+// Otherwise nothing is deducted and the state of the first pool short of energy is returned.
+func consumePoolAmounts(
+	config *economy.Config, data *storage.EnergyData, amounts economy.PoolAmounts, now int64,
+) (*pb.EnergyState, bool) {
+	if poolState := shortPool(config, data, amounts, now); poolState != nil {
+		return poolState, false
+	}
+
+	for _, poolId := range amounts.PoolIDs() {
+		consumePoolEnergy(config, data, poolId, amounts[poolId], now)
+	}
+
+	return nil, true
+}
+
+// refillCapacity returns how much energy a refill from source may bring a pool to:
+// its overflow ceiling if the source may overflow, else its max energy
+func refillCapacity(config *economy.Config, source string, state *pb.EnergyState) int32 {
+	if ceiling, ok := config.OverflowCeiling(source, state.PoolId); ok && ceiling > state.MaxEnergy {
+		return ceiling
+	}
+
+	return state.MaxEnergy
+}
+
+// refillPoolEnergy adds amount to a pool's regenerated energy up to capacity.
+// Energy already above capacity is never taken away.
+func refillPoolEnergy(
+	config *economy.Config, data *storage.EnergyData, poolId string, amount int32, capacity int32, now int64,
+) {
+	regen := regeneratePool(config, data, poolId, now)
+
+	// Calculate new energy (capped at capacity)
+	newEnergy := regen.energy + amount
+	if newEnergy > capacity {
+		newEnergy = max(capacity, regen.energy)
+	}
+
+	storePoolEnergy(config, data, poolId, newEnergy, regen.progress, now)
+}
+
+// refillPoolAmounts adds amounts to the pools, each up to its refill capacity for source
+func refillPoolAmounts(
+	config *economy.Config, data *storage.EnergyData, source string, amounts economy.PoolAmounts, now int64,
+) {
+	for _, poolId := range amounts.PoolIDs() {
+		capacity := refillCapacity(config, source, calculatePoolState(config, data, poolId, now))
+		refillPoolEnergy(config, data, poolId, amounts[poolId], capacity, now)
+	}
+}
+
+// formatPoolAmounts describes amounts per pool for response messages, e.g. "5 energy, 1 tickets"
+func formatPoolAmounts(amounts economy.PoolAmounts) string {
+	parts := make([]string, 0, len(amounts))
+	for _, poolId := range amounts.PoolIDs() {
+		parts = append(parts, fmt.Sprintf("%d %s", amounts[poolId], poolId))
+	}
+
+	return strings.Join(parts, ", ")
+}
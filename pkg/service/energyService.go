@@ -8,7 +8,10 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
+	"extend-custom-guild-service/pkg/economy"
 	pb "extend-custom-guild-service/pkg/pb"
+	"extend-custom-guild-service/pkg/purchase"
 	"extend-custom-guild-service/pkg/storage"
 	"fmt"
 	"math/rand"
@@ -16,56 +19,18 @@ import (
 	"time"
 
 	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/repository"
+	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
-// LootEntry defines a possible loot drop
-type LootEntry struct {
-	ItemID   string
-	ItemName string
-	MinQty   int32
-	MaxQty   int32
-	Weight   int // Higher weight = more common
-}
-
-// Energy cost per action type (server-authoritative)
-var actionEnergyCosts = map[string]int32{
-	"fight":   10,
-	"explore": 5,
-}
-
-// Refill amounts per source type (server-authoritative)
-var refillAmounts = map[string]int32{
-	"daily":    50, // Daily login bonus
-	"ad":       20, // Watch ad reward
-	"purchase": 100, // IAP full refill
-	"debug":    100, // Debug/testing - full refill
-}
-
-// Loot tables per action type
-var lootTables = map[string][]LootEntry{
-	"fight": {
-		{ItemID: "gold", ItemName: "Gold", MinQty: 5, MaxQty: 20, Weight: 50},
-		{ItemID: "iron_ore", ItemName: "Iron Ore", MinQty: 1, MaxQty: 3, Weight: 30},
-		{ItemID: "gem", ItemName: "Gem", MinQty: 1, MaxQty: 1, Weight: 10},
-		{ItemID: "sword_shard", ItemName: "Sword Shard", MinQty: 1, MaxQty: 2, Weight: 10},
-	},
-	"explore": {
-		{ItemID: "gold", ItemName: "Gold", MinQty: 2, MaxQty: 10, Weight: 40},
-		{ItemID: "herb", ItemName: "Herb", MinQty: 1, MaxQty: 5, Weight: 35},
-		{ItemID: "map_piece", ItemName: "Map Piece", MinQty: 1, MaxQty: 1, Weight: 15},
-		{ItemID: "gem", ItemName: "Gem", MinQty: 1, MaxQty: 1, Weight: 10},
-	},
-}
-
 // rollLoot randomly selects loot based on action type
-func rollLoot(actionType string) []*pb.LootItem {
-	table, exists := lootTables[actionType]
+func rollLoot(config *economy.Config, actionType string) []*pb.LootItem {
+	table, exists := config.LootTables[actionType]
 	if !exists {
-		// Default to fight loot if unknown action
-		table = lootTables["fight"]
+		// Action has no loot
+		return nil
 	}
 
 	var loot []*pb.LootItem
@@ -96,7 +61,7 @@ func rollLoot(actionType string) []*pb.LootItem {
 
 				loot = append(loot, &pb.LootItem{
 					ItemId:   entry.ItemID,
-					ItemName: entry.ItemName,
+					ItemName: config.ItemName(entry.ItemID),
 					Quantity: qty,
 				})
 				break
@@ -107,12 +72,25 @@ func rollLoot(actionType string) []*pb.LootItem {
 	return loot
 }
 
+// Number of read-modify-write attempts before a concurrently modified record is reported as aborted
+const maxSaveAttempts = 5
+
 type EnergyServiceServerImpl struct {
 	pb.UnimplementedServiceServer
 	tokenRepo   repository.TokenRepository
 	configRepo  repository.ConfigRepository
 	refreshRepo repository.RefreshTokenRepository
 	storage     storage.Storage
+	economy     economy.Provider
+	clock       Clock
+	purchases   purchase.Verifier
+	ledger      storage.LedgerStore
+
+	debugTimeOffsetEnabled bool
+
+	namespaceBoosts  *namespaceCache[[]storage.RegenBoost]
+	debugTimeOffsets *namespaceCache[int64]
+	flushedLedger    *flushedLedgerEntries
 }
 
 func NewEnergyServiceServer(
@@ -120,13 +98,27 @@ func NewEnergyServiceServer(
 	configRepo repository.ConfigRepository,
 	refreshRepo repository.RefreshTokenRepository,
 	storage storage.Storage,
+	economy economy.Provider,
+	opts ...Option,
 ) *EnergyServiceServerImpl {
-	return &EnergyServiceServerImpl{
+	s := &EnergyServiceServerImpl{
 		tokenRepo:   tokenRepo,
 		configRepo:  configRepo,
 		refreshRepo: refreshRepo,
 		storage:     storage,
+		economy:     economy,
+		clock:       systemClock{},
+
+		namespaceBoosts:  newNamespaceCache(storage.GetNamespaceBoosts),
+		debugTimeOffsets: newNamespaceCache(storage.GetDebugTimeOffset),
+		flushedLedger:    newFlushedLedgerEntries(),
+	}
+
+	for _, opt := range opts {
+		opt(s)
 	}
+
+	return s
 }
 
 // ============== PUBLIC ENDPOINTS (Game Client) ==============
@@ -138,12 +130,7 @@ func (s *EnergyServiceServerImpl) GetMyEnergy(
 ) (*pb.GetEnergyResponse, error) {
 	userId := req.UserId
 
-	energyState, err := s.getOrCreateEnergyState(ctx, req.Namespace, userId)
-	if err != nil {
-		return nil, err
-	}
-
-	return &pb.GetEnergyResponse{EnergyState: energyState}, nil
+	return s.getEnergy(ctx, req.Namespace, userId, req.PoolId)
 }
 
 // ConsumeMyEnergy deducts energy for the authenticated player
@@ -151,89 +138,87 @@ func (s *EnergyServiceServerImpl) ConsumeMyEnergy(
 	ctx context.Context, req *pb.ConsumeMyEnergyRequest,
 ) (*pb.ConsumeEnergyResponse, error) {
 	userId := req.UserId
+	economyConfig := s.economy.Current()
 
 	// Look up energy cost from server-side config (ignore client-sent amount)
-	energyCost, validAction := actionEnergyCosts[req.ActionType]
-	if !validAction {
+	if _, validAction := economyConfig.ActionCosts[req.ActionType]; !validAction {
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid action type: %s", req.ActionType)
 	}
 
-	// Get current state (with regeneration calculated)
-	energyState, err := s.getOrCreateEnergyState(ctx, req.Namespace, userId)
+	var energyState *pb.EnergyState
+	var newStates []*pb.EnergyState
+	var energyCosts economy.PoolAmounts
+	var loot []*pb.LootItem
+
+	entry := &storage.LedgerEntry{Actor: ledgerActorPlayer, ActionType: req.ActionType}
+	updatedData, err := s.updateEnergyData(ctx, req.Namespace, userId, entry,
+		func(data *storage.EnergyData, _ *pb.EnergyState, now int64) (bool, error) {
+			// The player's energy level may discount the cost
+			energyCosts, _ = economyConfig.ActionCost(req.ActionType, data.Level)
+
+			// Deduct energy (using server-authoritative cost) if enough in every pool the action costs
+			var enough bool
+			if energyState, enough = consumePoolAmounts(economyConfig, data, energyCosts, now); !enough {
+				return false, nil
+			}
+
+			// Roll loot (re-rolled on retry so it always matches the saved inventory)
+			loot = grantLoot(economyConfig, data, rollLoot(economyConfig, req.ActionType))
+
+			newStates = calculatePoolStates(economyConfig, data, energyCosts.PoolIDs(), now)
+
+			return true, nil
+		})
 	if err != nil {
 		return nil, err
 	}
 
-	// Check if enough energy
-	if energyState.CurrentEnergy < energyCost {
+	// Not enough energy, nothing was saved
+	if updatedData == nil {
 		return &pb.ConsumeEnergyResponse{
 			EnergyState: energyState,
 			Success:     false,
-			Message: fmt.Sprintf("Insufficient energy. Required: %d, Available: %d",
-				energyCost, energyState.CurrentEnergy),
+			Message: fmt.Sprintf("Insufficient %s. Required: %d, Available: %d",
+				energyState.PoolId, energyCosts[energyState.PoolId], energyState.CurrentEnergy),
 		}, nil
 	}
 
-	// Get current data to preserve inventory and calculate proper LastUpdateTime
-	currentData, _ := s.storage.GetEnergyData(ctx, req.Namespace, userId)
-	inventory := make(map[string]int32)
-	if currentData != nil && currentData.Inventory != nil {
-		inventory = currentData.Inventory
-	}
+	return &pb.ConsumeEnergyResponse{
+		EnergyState: newStates[0],
+		Success:     true,
+		Message:     fmt.Sprintf("Consumed %s for %s", formatPoolAmounts(energyCosts), req.ActionType),
+		Loot:        loot,
+		Pools:       newStates,
+	}, nil
+}
 
-	// Deduct energy (using server-authoritative cost)
-	newEnergy := energyState.CurrentEnergy - energyCost
-	now := time.Now().Unix()
+// ConsumeMyEnergyBatch performs a batch of actions for the authenticated player in one update
+func (s *EnergyServiceServerImpl) ConsumeMyEnergyBatch(
+	ctx context.Context, req *pb.ConsumeMyEnergyBatchRequest,
+) (*pb.ConsumeEnergyBatchResponse, error) {
+	userId := req.UserId
+	economyConfig := s.economy.Current()
 
-	// Calculate new LastUpdateTime
-	var newLastUpdateTime int64
-	if currentData != nil {
-		// If energy was at max before this action, start a fresh regen cycle
-		// (the old LastUpdateTime is stale since no regen was happening)
-		if energyState.CurrentEnergy >= energyState.MaxEnergy {
-			newLastUpdateTime = now
-		} else {
-			// Preserve position in current regen cycle
-			elapsed := now - currentData.LastUpdateTime
-			regenPoints := elapsed / int64(energyState.RegenRateSeconds)
-			newLastUpdateTime = currentData.LastUpdateTime + (regenPoints * int64(energyState.RegenRateSeconds))
-		}
-	} else {
-		newLastUpdateTime = now
+	// Look up energy costs from server-side config
+	if err := validateBatch(economyConfig, req.Actions); err != nil {
+		return nil, err
 	}
 
-	// Roll loot
-	loot := rollLoot(req.ActionType)
-
-	// Add loot to inventory
-	for _, item := range loot {
-		inventory[item.ItemId] += item.Quantity
-	}
+	var response *pb.ConsumeEnergyBatchResponse
 
-	// Save updated state with inventory
-	updatedData := &storage.EnergyData{
-		UserId:           userId,
-		CurrentEnergy:    newEnergy,
-		MaxEnergy:        energyState.MaxEnergy,
-		LastUpdateTime:   newLastUpdateTime,
-		RegenRateSeconds: energyState.RegenRateSeconds,
-		Level:            1,
-		Inventory:        inventory,
-	}
+	entry := &storage.LedgerEntry{Actor: ledgerActorPlayer, ActionType: batchActionTypes(req.Actions)}
+	_, err := s.updateEnergyData(ctx, req.Namespace, userId, entry,
+		func(data *storage.EnergyData, _ *pb.EnergyState, now int64) (bool, error) {
+			response = consumeBatch(economyConfig, data, req.Actions, req.Mode, now)
 
-	_, err = s.storage.SaveEnergyData(ctx, req.Namespace, userId, updatedData)
+			// Not enough energy for any action, nothing is saved
+			return response.Success, nil
+		})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to save energy data: %v", err)
+		return nil, err
 	}
 
-	newState := s.calculateEnergyState(updatedData)
-
-	return &pb.ConsumeEnergyResponse{
-		EnergyState: newState,
-		Success:     true,
-		Message:     fmt.Sprintf("Consumed %d energy for %s", energyCost, req.ActionType),
-		Loot:        loot,
-	}, nil
+	return response, nil
 }
 
 // RefillMyEnergy adds energy for the authenticated player
@@ -241,69 +226,135 @@ func (s *EnergyServiceServerImpl) RefillMyEnergy(
 	ctx context.Context, req *pb.RefillMyEnergyRequest,
 ) (*pb.RefillEnergyResponse, error) {
 	userId := req.UserId
+	economyConfig := s.economy.Current()
+	poolId := poolIdOrDefault(req.PoolId)
 
 	// Look up refill amount from server-side config (ignore client-sent amount)
-	refillAmount, validSource := refillAmounts[req.Source]
+	sourceAmounts, validSource := economyConfig.RefillSources[req.Source]
 	if !validSource {
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid refill source: %s", req.Source)
 	}
 
-	// Get current state
-	energyState, err := s.getOrCreateEnergyState(ctx, req.Namespace, userId)
-	if err != nil {
-		return nil, err
+	// A purchase refills every pool of the purchased item, once the purchase is verified.
+	// Other sources refill the requested pool.
+	var refillAmounts economy.PoolAmounts
+	purchaseItems, isPurchase := economyConfig.PurchaseItems[req.Source]
+	if isPurchase {
+		if s.purchases == nil {
+			return nil, status.Errorf(codes.Unavailable, "Purchase verification is not configured")
+		}
+		if req.TransactionId == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "Refill source %s requires the purchase transaction ID", req.Source)
+		}
+		if refillAmounts, validSource = purchaseItems[req.ItemId]; !validSource {
+			return nil, status.Errorf(codes.InvalidArgument, "Item %s does not refill energy", req.ItemId)
+		}
+
+		// The transaction is recorded without a pool, it refills all of them
+		poolId = ""
+	} else {
+		refillAmount, validPool := sourceAmounts[poolId]
+		if !validPool {
+			return nil, status.Errorf(codes.InvalidArgument, "Refill source %s does not refill pool %s", req.Source, poolId)
+		}
+		refillAmounts = economy.PoolAmounts{poolId: refillAmount}
 	}
 
-	// Get current data to preserve inventory
-	currentData, _ := s.storage.GetEnergyData(ctx, req.Namespace, userId)
-	inventory := make(map[string]int32)
-	if currentData != nil && currentData.Inventory != nil {
-		inventory = currentData.Inventory
+	policy, hasPolicy := economyConfig.RefillPolicies[req.Source]
+	if policy.Disabled(req.Namespace) {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"Refill source %s is disabled in namespace %s", req.Source, req.Namespace)
 	}
 
-	// Calculate new energy (capped at max)
-	newEnergy := energyState.CurrentEnergy + refillAmount
-	if newEnergy > energyState.MaxEnergy {
-		newEnergy = energyState.MaxEnergy
+	// The purchase is verified once, not again by every retry of the update after a save conflict.
+	// A transaction already processed replays its refill unverified, its purchase may be consumed.
+	var verified bool
+	if isPurchase {
+		processed, err := s.refillTransactionProcessed(ctx, req.Namespace, userId, req.TransactionId)
+		if err != nil {
+			return nil, err
+		}
+		if !processed {
+			if err := s.purchases.VerifyPurchase(ctx, req.Namespace, userId, req.TransactionId, req.ItemId); err != nil {
+				return nil, err
+			}
+			verified = true
+		}
 	}
 
-	now := time.Now().Unix()
+	var response *pb.RefillEnergyResponse
+	var consumePending bool
 
-	// Calculate new LastUpdateTime - only advance by actual regen that occurred
-	// This preserves the position in the current regen cycle
-	var newLastUpdateTime int64
-	if currentData != nil {
-		elapsed := now - currentData.LastUpdateTime
-		regenPoints := elapsed / int64(energyState.RegenRateSeconds)
-		// Only advance LastUpdateTime by the time that produced actual regen
-		newLastUpdateTime = currentData.LastUpdateTime + (regenPoints * int64(energyState.RegenRateSeconds))
-	} else {
-		newLastUpdateTime = now
+	entry := &storage.LedgerEntry{
+		Actor:         ledgerActorPlayer,
+		Source:        req.Source,
+		TransactionId: req.TransactionId,
 	}
+	_, err := s.updateEnergyData(ctx, req.Namespace, userId, entry,
+		func(data *storage.EnergyData, _ *pb.EnergyState, now int64) (bool, error) {
+			// A retried transaction gets the original response without refilling again
+			replayed, err := findRefillTransaction(data, req.TransactionId, req.Source, poolId, req.ItemId)
+			if err != nil || replayed != nil {
+				response = replayed
+				consumePending = isPurchase && refillTransactionPending(data, req.TransactionId)
+				return false, err
+			}
 
-	// Save updated state (preserve inventory)
-	updatedData := &storage.EnergyData{
-		UserId:           userId,
-		CurrentEnergy:    newEnergy,
-		MaxEnergy:        energyState.MaxEnergy,
-		LastUpdateTime:   newLastUpdateTime,
-		RegenRateSeconds: energyState.RegenRateSeconds,
-		Level:            1,
-		Inventory:        inventory,
-	}
+			// The transaction was dropped from the record since it was found processed
+			if isPurchase && !verified {
+				return false, status.Errorf(codes.Aborted,
+					"Transaction %s was modified concurrently, please retry", req.TransactionId)
+			}
+
+			pools := calculatePoolStates(economyConfig, data, refillAmounts.PoolIDs(), now)
+			nowTime := time.UnixMilli(now)
+
+			// Enforce the cooldown and daily limit of the source
+			if hasPolicy {
+				usage := refillUsage(policy, data, req.Source, nowTime)
+				if nextRefillTime(policy, usage, nowTime) > 0 {
+					response = &pb.RefillEnergyResponse{
+						EnergyState: pools[0],
+						Success:     false,
+						Message:     fmt.Sprintf("Refill source %s is not available yet", req.Source),
+						Pools:       pools,
+					}
+					setRefillAvailability(response, policy, usage, nowTime)
+
+					return false, nil
+				}
+			}
+
+			refillPoolAmounts(economyConfig, data, req.Source, refillAmounts, now)
+
+			pools = calculatePoolStates(economyConfig, data, refillAmounts.PoolIDs(), now)
+			response = &pb.RefillEnergyResponse{
+				EnergyState: pools[0],
+				Success:     true,
+				Message:     fmt.Sprintf("Refilled %s from %s", formatPoolAmounts(refillAmounts), req.Source),
+				Pools:       pools,
+			}
+
+			if hasPolicy {
+				recordRefillUse(policy, data, req.Source, nowTime)
+				setRefillAvailability(response, policy, data.RefillUsage[req.Source], nowTime)
+			}
 
-	_, err = s.storage.SaveEnergyData(ctx, req.Namespace, userId, updatedData)
+			consumePending = isPurchase
+			return true, recordRefillTransaction(
+				data, req.TransactionId, req.Source, poolId, req.ItemId, response, isPurchase, now)
+		})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to save energy data: %v", err)
+		return nil, err
 	}
 
-	newState := s.calculateEnergyState(updatedData)
+	// The purchase is consumed once its refill is saved. The refill stands even if that fails:
+	// the transaction stays pending and keeps replaying this refill, and a retry with it consumes it.
+	if consumePending {
+		s.consumePurchase(ctx, req.Namespace, userId, req.TransactionId)
+	}
 
-	return &pb.RefillEnergyResponse{
-		EnergyState: newState,
-		Success:     true,
-		Message:     fmt.Sprintf("Refilled %d energy from %s", refillAmount, req.Source),
-	}, nil
+	return response, nil
 }
 
 // GetMyInventory returns the inventory for the authenticated player
@@ -313,36 +364,72 @@ func (s *EnergyServiceServerImpl) GetMyInventory(
 	userId := req.UserId
 
 	data, err := s.storage.GetEnergyData(ctx, req.Namespace, userId)
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return nil, err
+	}
+
+	response := &pb.GetInventoryResponse{}
+	if data != nil {
+		// Convert maps to lists with the catalog's item details
+		economyConfig := s.economy.Current()
+		response.Items = toInventoryItems(economyConfig, data.Inventory, req.Language)
+		response.PendingItems = toInventoryItems(economyConfig, data.PendingItems, req.Language)
+	}
+
+	return response, nil
+}
+
+// SpendMyItems removes items from the authenticated player's inventory, all or none
+func (s *EnergyServiceServerImpl) SpendMyItems(
+	ctx context.Context, req *pb.SpendMyItemsRequest,
+) (*pb.UpdateInventoryResponse, error) {
+	entry := &storage.LedgerEntry{Actor: ledgerActorPlayer, Reason: req.Reason}
+
+	return s.removeItems(ctx, req.Namespace, req.UserId, req.Items, entry)
+}
+
+// ClaimMyPendingItems moves as much of the authenticated player's pending loot into the inventory as fits
+func (s *EnergyServiceServerImpl) ClaimMyPendingItems(
+	ctx context.Context, req *pb.ClaimMyPendingItemsRequest,
+) (*pb.UpdateInventoryResponse, error) {
+	economyConfig := s.economy.Current()
+
+	var currentData *storage.EnergyData
+	var claimed map[string]int32
+
+	entry := &storage.LedgerEntry{Actor: ledgerActorPlayer}
+	updatedData, err := s.updateEnergyData(ctx, req.Namespace, req.UserId, entry,
+		func(data *storage.EnergyData, _ *pb.EnergyState, _ int64) (bool, error) {
+			currentData = data
+			claimed = claimPendingItems(economyConfig, data)
+
+			return len(claimed) > 0, nil
+		})
 	if err != nil {
 		return nil, err
 	}
 
-	var items []*pb.InventoryItem
-	if data != nil && data.Inventory != nil {
-		// Convert map to list with item names
-		itemNames := map[string]string{
-			"gold":        "Gold",
-			"iron_ore":    "Iron Ore",
-			"gem":         "Gem",
-			"sword_shard": "Sword Shard",
-			"herb":        "Herb",
-			"map_piece":   "Map Piece",
+	// Nothing pending or no room for any of it, nothing was saved
+	if updatedData == nil {
+		message := "No pending items to claim"
+		if len(currentData.PendingItems) > 0 {
+			message = "No room in the inventory for the pending items"
 		}
 
-		for itemId, qty := range data.Inventory {
-			name := itemId
-			if n, ok := itemNames[itemId]; ok {
-				name = n
-			}
-			items = append(items, &pb.InventoryItem{
-				ItemId:   itemId,
-				ItemName: name,
-				Quantity: qty,
-			})
-		}
+		return &pb.UpdateInventoryResponse{
+			Items:        toInventoryItems(economyConfig, currentData.Inventory, ""),
+			Success:      false,
+			Message:      message,
+			PendingItems: toInventoryItems(economyConfig, currentData.PendingItems, ""),
+		}, nil
 	}
 
-	return &pb.GetInventoryResponse{Items: items}, nil
+	return &pb.UpdateInventoryResponse{
+		Items:        toInventoryItems(economyConfig, updatedData.Inventory, ""),
+		Success:      true,
+		Message:      fmt.Sprintf("Claimed %s", formatItemQuantities(claimed)),
+		PendingItems: toInventoryItems(economyConfig, updatedData.PendingItems, ""),
+	}, nil
 }
 
 // GetMyEnergyConfig returns the energy config for the authenticated player
@@ -352,376 +439,1295 @@ func (s *EnergyServiceServerImpl) GetMyEnergyConfig(
 	userId := req.UserId
 
 	data, err := s.storage.GetEnergyData(ctx, req.Namespace, userId)
-	if err != nil {
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
 		return nil, err
 	}
 
-	// If no data exists, return defaults
+	// If no data exists, return the namespace defaults
 	if data == nil {
+		defaults, err := s.getNamespaceConfig(ctx, req.Namespace)
+		if err != nil {
+			return nil, err
+		}
+
 		return &pb.GetEnergyConfigResponse{
 			Config: &pb.EnergyConfig{
 				UserId:           userId,
-				MaxEnergy:        storage.DefaultMaxEnergy,
-				RegenRateSeconds: storage.DefaultRegenRateSeconds,
+				MaxEnergy:        defaults.MaxEnergy,
+				RegenRateSeconds: defaults.RegenRateSeconds,
 				Level:            storage.DefaultLevel,
 			},
 		}, nil
 	}
 
 	return &pb.GetEnergyConfigResponse{
-		Config: &pb.EnergyConfig{
-			UserId:           data.UserId,
-			MaxEnergy:        data.MaxEnergy,
-			RegenRateSeconds: data.RegenRateSeconds,
-			Level:            data.Level,
-		},
+		Config: toEnergyConfig(s.economy.Current(), data),
 	}, nil
 }
 
-// ============== ADMIN ENDPOINTS (Backend/Tools) ==============
-// Explicit user_id in request
+// LevelUpMyEnergy spends the upgrade cost of the next energy level for the authenticated player
+func (s *EnergyServiceServerImpl) LevelUpMyEnergy(
+	ctx context.Context, req *pb.LevelUpMyEnergyRequest,
+) (*pb.SetEnergyLevelResponse, error) {
+	userId := req.UserId
+	economyConfig := s.economy.Current()
 
-// GetEnergy returns the current energy state for a player (admin)
-func (s *EnergyServiceServerImpl) GetEnergy(
-	ctx context.Context, req *pb.GetEnergyRequest,
-) (*pb.GetEnergyResponse, error) {
-	energyState, err := s.getOrCreateEnergyState(ctx, req.Namespace, req.UserId)
-	if err != nil {
-		return nil, err
-	}
+	var currentData *storage.EnergyData
+	var energyState *pb.EnergyState
+	var shortfall string
 
-	return &pb.GetEnergyResponse{EnergyState: energyState}, nil
-}
+	entry := &storage.LedgerEntry{Actor: ledgerActorPlayer}
+	updatedData, err := s.updateEnergyData(ctx, req.Namespace, userId, entry,
+		func(data *storage.EnergyData, state *pb.EnergyState, now int64) (bool, error) {
+			currentData, energyState = data, state
 
-// ConsumeEnergy deducts energy for an action (admin)
-func (s *EnergyServiceServerImpl) ConsumeEnergy(
-	ctx context.Context, req *pb.ConsumeEnergyRequest,
-) (*pb.ConsumeEnergyResponse, error) {
-	// Validate amount
-	if req.Amount <= 0 {
-		return nil, status.Errorf(codes.InvalidArgument, "Amount must be positive")
-	}
+			nextLevel, ok := economyConfig.Level(data.Level + 1)
+			if !ok {
+				return false, status.Errorf(codes.FailedPrecondition,
+					"Already at the highest energy level %d", data.Level)
+			}
+
+			// Check and spend the required items
+			shortfall = spendUpgradeCost(data, nextLevel)
+			if shortfall != "" {
+				return false, nil
+			}
 
-	// Get current state (with regeneration calculated)
-	energyState, err := s.getOrCreateEnergyState(ctx, req.Namespace, req.UserId)
+			setEnergyLevel(economyConfig, data, nextLevel.Level, now)
+			energyState = calculatePoolState(economyConfig, data, economy.DefaultPoolID, now)
+
+			return true, nil
+		})
 	if err != nil {
 		return nil, err
 	}
 
-	// Check if enough energy
-	if energyState.CurrentEnergy < req.Amount {
-		return &pb.ConsumeEnergyResponse{
+	// Not enough items, nothing was saved
+	if updatedData == nil {
+		return &pb.SetEnergyLevelResponse{
 			EnergyState: energyState,
+			Config:      toEnergyConfig(economyConfig, currentData),
 			Success:     false,
-			Message: fmt.Sprintf("Insufficient energy. Required: %d, Available: %d",
-				req.Amount, energyState.CurrentEnergy),
+			Message:     shortfall,
 		}, nil
 	}
 
-	// Deduct energy
-	newEnergy := energyState.CurrentEnergy - req.Amount
-	now := time.Now().Unix()
-
-	// Save updated state
-	updatedData := &storage.EnergyData{
-		UserId:           req.UserId,
-		CurrentEnergy:    newEnergy,
-		MaxEnergy:        energyState.MaxEnergy,
-		LastUpdateTime:   now,
-		RegenRateSeconds: energyState.RegenRateSeconds,
-		Level:            1,
-	}
-
-	_, err = s.storage.SaveEnergyData(ctx, req.Namespace, req.UserId, updatedData)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to save energy data: %v", err)
-	}
-
-	newState := s.calculateEnergyState(updatedData)
-
-	return &pb.ConsumeEnergyResponse{
-		EnergyState: newState,
+	return &pb.SetEnergyLevelResponse{
+		EnergyState: energyState,
+		Config:      toEnergyConfig(economyConfig, updatedData),
 		Success:     true,
-		Message:     fmt.Sprintf("Consumed %d energy for %s", req.Amount, req.ActionType),
+		Message:     fmt.Sprintf("Energy level upgraded to %d", updatedData.Level),
 	}, nil
 }
 
-// RefillEnergy adds energy to the player's pool (admin)
-func (s *EnergyServiceServerImpl) RefillEnergy(
-	ctx context.Context, req *pb.RefillEnergyRequest,
-) (*pb.RefillEnergyResponse, error) {
-	// Validate amount
-	if req.Amount <= 0 {
-		return nil, status.Errorf(codes.InvalidArgument, "Amount must be positive")
-	}
+// ReserveEnergy deducts the cost of an action into a hold for the authenticated player
+func (s *EnergyServiceServerImpl) ReserveEnergy(
+	ctx context.Context, req *pb.ReserveEnergyRequest,
+) (*pb.ReserveEnergyResponse, error) {
+	userId := req.UserId
+	economyConfig := s.economy.Current()
 
-	// Get current state
-	energyState, err := s.getOrCreateEnergyState(ctx, req.Namespace, req.UserId)
+	if _, validAction := economyConfig.ActionCosts[req.ActionType]; !validAction {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid action type: %s", req.ActionType)
+	}
+	ttl, err := holdTTL(req.TtlSeconds)
 	if err != nil {
 		return nil, err
 	}
 
-	// Calculate new energy (capped at max)
-	newEnergy := energyState.CurrentEnergy + req.Amount
-	if newEnergy > energyState.MaxEnergy {
-		newEnergy = energyState.MaxEnergy
-	}
-
-	now := time.Now().Unix()
+	var energyState *pb.EnergyState
+	var newStates []*pb.EnergyState
+	var energyCosts economy.PoolAmounts
+	var hold storage.EnergyHold
+
+	entry := &storage.LedgerEntry{Actor: ledgerActorPlayer, ActionType: req.ActionType}
+	updatedData, err := s.updateEnergyData(ctx, req.Namespace, userId, entry,
+		func(data *storage.EnergyData, _ *pb.EnergyState, now int64) (bool, error) {
+			if len(data.Holds) >= maxEnergyHolds {
+				return false, status.Errorf(codes.FailedPrecondition,
+					"Too many open holds, commit or release one first (max %d)", maxEnergyHolds)
+			}
 
-	// Save updated state
-	updatedData := &storage.EnergyData{
-		UserId:           req.UserId,
-		CurrentEnergy:    newEnergy,
-		MaxEnergy:        energyState.MaxEnergy,
-		LastUpdateTime:   now,
-		RegenRateSeconds: energyState.RegenRateSeconds,
-		Level:            1,
-	}
+			// The player's energy level may discount the cost
+			energyCosts, _ = economyConfig.ActionCost(req.ActionType, data.Level)
 
-	_, err = s.storage.SaveEnergyData(ctx, req.Namespace, req.UserId, updatedData)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to save energy data: %v", err)
-	}
+			var enough bool
+			if energyState, enough = consumePoolAmounts(economyConfig, data, energyCosts, now); !enough {
+				return false, nil
+			}
 
-	newState := s.calculateEnergyState(updatedData)
+			hold = storage.EnergyHold{
+				HoldId:     uuid.NewString(),
+				ActionType: req.ActionType,
+				Costs:      energyCosts,
+				CreatedAt:  now / 1000,
+				ExpiresAt:  (now + ttl.Milliseconds()) / 1000,
+			}
+			data.Holds = append(data.Holds, hold)
+			entry.ActionId = hold.HoldId
 
-	return &pb.RefillEnergyResponse{
-		EnergyState: newState,
-		Success:     true,
-		Message:     fmt.Sprintf("Refilled %d energy from %s", req.Amount, req.Source),
-	}, nil
-}
+			newStates = calculatePoolStates(economyConfig, data, energyCosts.PoolIDs(), now)
 
-// GetEnergyConfig returns the player's energy configuration (admin)
-func (s *EnergyServiceServerImpl) GetEnergyConfig(
-	ctx context.Context, req *pb.GetEnergyConfigRequest,
-) (*pb.GetEnergyConfigResponse, error) {
-	data, err := s.storage.GetEnergyData(ctx, req.Namespace, req.UserId)
+			return true, nil
+		})
 	if err != nil {
 		return nil, err
 	}
 
-	// If no data exists, return defaults
-	if data == nil {
-		return &pb.GetEnergyConfigResponse{
-			Config: &pb.EnergyConfig{
-				UserId:           req.UserId,
-				MaxEnergy:        storage.DefaultMaxEnergy,
-				RegenRateSeconds: storage.DefaultRegenRateSeconds,
-				Level:            storage.DefaultLevel,
-			},
+	// Not enough energy, nothing was saved
+	if updatedData == nil {
+		return &pb.ReserveEnergyResponse{
+			EnergyState: energyState,
+			Success:     false,
+			Message: fmt.Sprintf("Insufficient %s. Required: %d, Available: %d",
+				energyState.PoolId, energyCosts[energyState.PoolId], energyState.CurrentEnergy),
 		}, nil
 	}
 
-	return &pb.GetEnergyConfigResponse{
-		Config: &pb.EnergyConfig{
-			UserId:           data.UserId,
-			MaxEnergy:        data.MaxEnergy,
-			RegenRateSeconds: data.RegenRateSeconds,
-			Level:            data.Level,
-		},
+	return &pb.ReserveEnergyResponse{
+		Hold:        toEnergyHold(hold),
+		EnergyState: newStates[0],
+		Success:     true,
+		Message:     fmt.Sprintf("Reserved %s for %s", formatPoolAmounts(energyCosts), req.ActionType),
+		Pools:       newStates,
 	}, nil
 }
 
-// UpdateEnergyConfig updates the player's energy configuration (admin)
-func (s *EnergyServiceServerImpl) UpdateEnergyConfig(
-	ctx context.Context, req *pb.UpdateEnergyConfigRequest,
-) (*pb.UpdateEnergyConfigResponse, error) {
-	// Get current state
-	energyState, err := s.getOrCreateEnergyState(ctx, req.Namespace, req.UserId)
+// CommitEnergy finalizes a hold of the authenticated player and rolls the loot of its action
+func (s *EnergyServiceServerImpl) CommitEnergy(
+	ctx context.Context, req *pb.CommitEnergyRequest,
+) (*pb.CommitEnergyResponse, error) {
+	userId := req.UserId
+	economyConfig := s.economy.Current()
+
+	var hold storage.EnergyHold
+	var loot []*pb.LootItem
+
+	entry := &storage.LedgerEntry{Actor: ledgerActorPlayer, ActionId: req.HoldId}
+	_, err := s.updateEnergyData(ctx, req.Namespace, userId, entry,
+		func(data *storage.EnergyData, _ *pb.EnergyState, _ int64) (bool, error) {
+			index, err := findHold(data, req.HoldId)
+			if err != nil {
+				return false, err
+			}
+			hold = data.Holds[index]
+			entry.ActionType = hold.ActionType
+			removeHold(data, index)
+
+			// Roll loot (re-rolled on retry so it always matches the saved inventory)
+			loot = grantLoot(economyConfig, data, rollLoot(economyConfig, hold.ActionType))
+
+			return true, nil
+		})
 	if err != nil {
 		return nil, err
 	}
 
-	// Apply updates (0 = no change)
-	newMaxEnergy := energyState.MaxEnergy
-	newRegenRate := energyState.RegenRateSeconds
+	return &pb.CommitEnergyResponse{
+		Hold:    toEnergyHold(hold),
+		Success: true,
+		Message: fmt.Sprintf("Consumed %s for %s", formatPoolAmounts(hold.Costs), hold.ActionType),
+		Loot:    loot,
+	}, nil
+}
 
-	if req.MaxEnergy > 0 {
-		newMaxEnergy = req.MaxEnergy
-	}
-	if req.RegenRateSeconds > 0 {
-		newRegenRate = req.RegenRateSeconds
-	}
+// ReleaseEnergy cancels a hold of the authenticated player and refunds its energy
+func (s *EnergyServiceServerImpl) ReleaseEnergy(
+	ctx context.Context, req *pb.ReleaseEnergyRequest,
+) (*pb.ReleaseEnergyResponse, error) {
+	userId := req.UserId
+	economyConfig := s.economy.Current()
 
-	now := time.Now().Unix()
+	var hold storage.EnergyHold
+	var newStates []*pb.EnergyState
 
-	// Save updated config
-	updatedData := &storage.EnergyData{
-		UserId:           req.UserId,
-		CurrentEnergy:    energyState.CurrentEnergy,
-		MaxEnergy:        newMaxEnergy,
-		LastUpdateTime:   now,
-		RegenRateSeconds: newRegenRate,
-		Level:            1,
-	}
+	entry := &storage.LedgerEntry{Actor: ledgerActorPlayer, ActionId: req.HoldId}
+	_, err := s.updateEnergyData(ctx, req.Namespace, userId, entry,
+		func(data *storage.EnergyData, _ *pb.EnergyState, now int64) (bool, error) {
+			index, err := findHold(data, req.HoldId)
+			if err != nil {
+				return false, err
+			}
+			hold = data.Holds[index]
+			entry.ActionType = hold.ActionType
+			releaseHold(economyConfig, data, index, now)
+
+			newStates = calculatePoolStates(economyConfig, data, economy.PoolAmounts(hold.Costs).PoolIDs(), now)
 
-	_, err = s.storage.SaveEnergyData(ctx, req.Namespace, req.UserId, updatedData)
+			return true, nil
+		})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to save config: %v", err)
+		return nil, err
 	}
 
-	return &pb.UpdateEnergyConfigResponse{
-		Config: &pb.EnergyConfig{
-			UserId:           req.UserId,
-			MaxEnergy:        newMaxEnergy,
-			RegenRateSeconds: newRegenRate,
-			Level:            1,
-		},
+	return &pb.ReleaseEnergyResponse{
+		Hold:    toEnergyHold(hold),
 		Success: true,
-		Message: "Energy configuration updated",
+		Message: fmt.Sprintf("Released %s held for %s", formatPoolAmounts(hold.Costs), hold.ActionType),
+		Pools:   newStates,
 	}, nil
 }
 
-// ResetEnergy resets a player's energy state to defaults (admin only)
-func (s *EnergyServiceServerImpl) ResetEnergy(
-	ctx context.Context, req *pb.ResetEnergyRequest,
-) (*pb.ResetEnergyResponse, error) {
-	now := time.Now().Unix()
-
-	// Create fresh default state
-	defaultData := &storage.EnergyData{
-		UserId:           req.UserId,
-		CurrentEnergy:    storage.DefaultStartingEnergy,
-		MaxEnergy:        storage.DefaultMaxEnergy,
-		LastUpdateTime:   now,
-		RegenRateSeconds: storage.DefaultRegenRateSeconds,
-		Level:            storage.DefaultLevel,
-	}
+// ListMyEnergyHistory returns the ledger of the authenticated player, newest first
+func (s *EnergyServiceServerImpl) ListMyEnergyHistory(
+	ctx context.Context, req *pb.ListMyEnergyHistoryRequest,
+) (*pb.ListEnergyHistoryResponse, error) {
+	return s.listLedgerEntries(ctx, req.Namespace, req.UserId, req.StartTime, req.EndTime, req.PageSize, req.Cursor)
+}
 
-	_, err := s.storage.SaveEnergyData(ctx, req.Namespace, req.UserId, defaultData)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to reset energy: %v", err)
-	}
+// ListItemCatalog returns the items players can own, optionally of one category
+func (s *EnergyServiceServerImpl) ListItemCatalog(
+	_ context.Context, req *pb.ListItemCatalogRequest,
+) (*pb.ListItemCatalogResponse, error) {
+	economyConfig := s.economy.Current()
+
+	response := &pb.ListItemCatalogResponse{Version: economyConfig.Version}
+	for _, itemId := range economyConfig.ItemIDs() {
+		item, _ := economyConfig.Item(itemId)
+		if req.Category != "" && item.Category != req.Category {
+			continue
+		}
 
-	newState := s.calculateEnergyState(defaultData)
+		response.Items = append(response.Items, toCatalogItem(itemId, item, req.Language))
+	}
 
-	return &pb.ResetEnergyResponse{
-		EnergyState: newState,
-		Success:     true,
-		Message:     "Energy state reset to defaults",
-	}, nil
+	return response, nil
 }
 
-// ============== Helper Methods ==============
+// CraftItem crafts a recipe for the authenticated player
+func (s *EnergyServiceServerImpl) CraftItem(
+	ctx context.Context, req *pb.CraftItemRequest,
+) (*pb.CraftItemResponse, error) {
+	userId := req.UserId
+	economyConfig := s.economy.Current()
 
-// extractUserIdFromToken extracts the user ID from the JWT token in the context
-func (s *EnergyServiceServerImpl) extractUserIdFromToken(ctx context.Context) (string, error) {
-	meta, ok := metadata.FromIncomingContext(ctx)
+	recipe, ok := economyConfig.Recipe(req.RecipeId)
 	if !ok {
-		return "", status.Errorf(codes.Unauthenticated, "missing metadata")
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid recipe: %s", req.RecipeId)
 	}
 
-	authHeader := meta.Get("authorization")
-	if len(authHeader) == 0 {
-		return "", status.Errorf(codes.Unauthenticated, "missing authorization header")
-	}
+	var currentData *storage.EnergyData
+	var energyState *pb.EnergyState
+	var pools []*pb.EnergyState
+	var result craftResult
 
-	token := strings.TrimPrefix(authHeader[0], "Bearer ")
+	entry := &storage.LedgerEntry{Actor: ledgerActorPlayer, ActionId: req.RecipeId}
+	updatedData, err := s.updateEnergyData(ctx, req.Namespace, userId, entry,
+		func(data *storage.EnergyData, state *pb.EnergyState, now int64) (bool, error) {
+			currentData, energyState = data, state
 
-	// JWT has 3 parts: header.payload.signature
-	parts := strings.Split(token, ".")
-	if len(parts) != 3 {
-		return "", status.Errorf(codes.Unauthenticated, "invalid token format")
-	}
+			var err error
+			result, err = craftRecipe(economyConfig, data, req.RecipeId, recipe, now)
+			if err != nil || !result.success {
+				return false, err
+			}
 
-	// Decode payload (middle part)
-	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+			energyState = calculatePoolState(economyConfig, data, economy.DefaultPoolID, now)
+			pools = calculatePoolStates(economyConfig, data, recipe.EnergyCost.PoolIDs(), now)
+			if len(pools) > 0 {
+				energyState = pools[0]
+			}
+
+			return true, nil
+		})
 	if err != nil {
-		return "", status.Errorf(codes.Unauthenticated, "failed to decode token payload")
+		return nil, err
 	}
 
-	// Parse JSON to extract sub (user ID)
-	var claims struct {
-		Sub string `json:"sub"`
-	}
-	if err := json.Unmarshal(payload, &claims); err != nil {
-		return "", status.Errorf(codes.Unauthenticated, "failed to parse token claims")
-	}
+	// Short of an input or energy, nothing was saved
+	if updatedData == nil {
+		if result.shortState != nil {
+			energyState = result.shortState
+		}
 
-	if claims.Sub == "" {
-		return "", status.Errorf(codes.Unauthenticated, "user ID not found in token")
+		return &pb.CraftItemResponse{
+			EnergyState: energyState,
+			Success:     false,
+			Message:     result.message,
+			Items:       toInventoryItems(economyConfig, currentData.Inventory, ""),
+			Shortfalls:  result.shortfalls,
+		}, nil
 	}
 
-	return claims.Sub, nil
+	return &pb.CraftItemResponse{
+		EnergyState: energyState,
+		Success:     true,
+		Message:     result.message,
+		Crafted:     result.crafted,
+		Outputs:     result.outputs,
+		Items:       toInventoryItems(economyConfig, updatedData.Inventory, ""),
+		Pools:       pools,
+	}, nil
 }
 
-// getOrCreateEnergyState gets existing energy or creates default for new players
-func (s *EnergyServiceServerImpl) getOrCreateEnergyState(
-	ctx context.Context, namespace string, userId string,
-) (*pb.EnergyState, error) {
-	data, err := s.storage.GetEnergyData(ctx, namespace, userId)
+// ListRecipes returns every recipe with whether the authenticated player can craft it now
+func (s *EnergyServiceServerImpl) ListRecipes(
+	ctx context.Context, req *pb.ListRecipesRequest,
+) (*pb.ListRecipesResponse, error) {
+	economyConfig := s.economy.Current()
+
+	data, now, err := s.currentEnergyData(ctx, req.Namespace, req.UserId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to get energy data: %v", err)
+		return nil, err
 	}
 
-	// New player - create default energy state
-	if data == nil {
-		now := time.Now().Unix()
-		data = &storage.EnergyData{
-			UserId:           userId,
-			CurrentEnergy:    storage.DefaultStartingEnergy,
-			MaxEnergy:        storage.DefaultMaxEnergy,
-			LastUpdateTime:   now,
-			RegenRateSeconds: storage.DefaultRegenRateSeconds,
-			Level:            storage.DefaultLevel,
-		}
-
-		// Save the initial state
-		_, err = s.storage.SaveEnergyData(ctx, namespace, userId, data)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Failed to initialize energy: %v", err)
-		}
+	response := &pb.ListRecipesResponse{}
+	for _, recipeId := range economyConfig.RecipeIDs() {
+		recipe, _ := economyConfig.Recipe(recipeId)
+		response.Recipes = append(response.Recipes, toRecipe(economyConfig, data, recipeId, recipe, now, req.Language))
 	}
 
-	// Calculate current energy with regeneration
-	return s.calculateEnergyState(data), nil
+	return response, nil
 }
 
-// calculateEnergyState applies time-based regeneration to stored data
-func (s *EnergyServiceServerImpl) calculateEnergyState(data *storage.EnergyData) *pb.EnergyState {
-	now := time.Now().Unix()
-
-	// Calculate regenerated energy since last update
-	elapsedSeconds := now - data.LastUpdateTime
-	regenPoints := int32(0)
+// ExchangeForEnergy spends items of the authenticated player for the energy of an exchange offer
+func (s *EnergyServiceServerImpl) ExchangeForEnergy(
+	ctx context.Context, req *pb.ExchangeForEnergyRequest,
+) (*pb.ExchangeForEnergyResponse, error) {
+	userId := req.UserId
+	economyConfig := s.economy.Current()
 
-	if data.RegenRateSeconds > 0 && elapsedSeconds > 0 {
-		regenPoints = int32(elapsedSeconds / int64(data.RegenRateSeconds))
+	offer, ok := economyConfig.ExchangeOffer(req.OfferId)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid exchange offer: %s", req.OfferId)
 	}
-
-	// Apply regeneration (capped at max)
-	currentEnergy := data.CurrentEnergy + regenPoints
-	if currentEnergy > data.MaxEnergy {
-		currentEnergy = data.MaxEnergy
+	if offer.Disabled(req.Namespace) {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"Exchange offer %s is disabled in namespace %s", req.OfferId, req.Namespace)
 	}
 
-	// Calculate time to next regen and time to max
-	energyToMax := data.MaxEnergy - currentEnergy
-	var nextRegenTime int64 = 0
-	var timeToMaxSeconds int64 = 0
+	var currentData *storage.EnergyData
+	var response *pb.ExchangeForEnergyResponse
 
-	if currentEnergy < data.MaxEnergy {
-		// Time until next energy point
-		usedSeconds := elapsedSeconds % int64(data.RegenRateSeconds)
-		secondsToNext := int64(data.RegenRateSeconds) - usedSeconds
-		nextRegenTime = now + secondsToNext
-
-		// Total time to reach max
-		timeToMaxSeconds = int64(energyToMax) * int64(data.RegenRateSeconds)
-		// Subtract the partial regen time
-		timeToMaxSeconds -= usedSeconds
+	entry := &storage.LedgerEntry{
+		Actor:    ledgerActorPlayer,
+		ActionId: req.OfferId,
+		Source:   economy.ExchangeSource,
+	}
+	updatedData, err := s.updateEnergyData(ctx, req.Namespace, userId, entry,
+		func(data *storage.EnergyData, _ *pb.EnergyState, now int64) (bool, error) {
+			currentData = data
+			response = exchangeForEnergy(economyConfig, data, req.OfferId, offer, now)
+
+			// Not available, short of an item or full, nothing is saved
+			return response.Success, nil
+		})
+	if err != nil {
+		return nil, err
 	}
 
-	return &pb.EnergyState{
-		UserId:           data.UserId,
-		CurrentEnergy:    currentEnergy,
-		MaxEnergy:        data.MaxEnergy,
-		LastUpdateTime:   data.LastUpdateTime,
-		RegenRateSeconds: data.RegenRateSeconds,
-		NextRegenTime:    nextRegenTime,
-		EnergyToMax:      energyToMax,
-		TimeToMaxSeconds: timeToMaxSeconds,
+	if updatedData != nil {
+		currentData = updatedData
 	}
+	response.Items = toInventoryItems(economyConfig, currentData.Inventory, "")
+
+	return response, nil
+}
+
+// ============== ADMIN ENDPOINTS (Backend/Tools) ==============
+// Explicit user_id in request
+
+// GetEnergy returns the current energy state for a player (admin)
+func (s *EnergyServiceServerImpl) GetEnergy(
+	ctx context.Context, req *pb.GetEnergyRequest,
+) (*pb.GetEnergyResponse, error) {
+	return s.getEnergy(ctx, req.Namespace, req.UserId, req.PoolId)
+}
+
+// ConsumeEnergy deducts energy for an action (admin)
+func (s *EnergyServiceServerImpl) ConsumeEnergy(
+	ctx context.Context, req *pb.ConsumeEnergyRequest,
+) (*pb.ConsumeEnergyResponse, error) {
+	// Validate amount
+	if req.Amount <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Amount must be positive")
+	}
+
+	economyConfig := s.economy.Current()
+	poolId := poolIdOrDefault(req.PoolId)
+	if err := validatePool(economyConfig, poolId); err != nil {
+		return nil, err
+	}
+
+	var energyState *pb.EnergyState
+
+	entry := &storage.LedgerEntry{Actor: ledgerActorAdmin, ActionType: req.ActionType}
+	updatedData, err := s.updateEnergyData(ctx, req.Namespace, req.UserId, entry,
+		func(data *storage.EnergyData, _ *pb.EnergyState, now int64) (bool, error) {
+			energyState = calculatePoolState(economyConfig, data, poolId, now)
+
+			// Check if enough energy
+			if energyState.CurrentEnergy < req.Amount {
+				return false, nil
+			}
+
+			// Deduct energy
+			consumePoolEnergy(economyConfig, data, poolId, req.Amount, now)
+			energyState = calculatePoolState(economyConfig, data, poolId, now)
+
+			return true, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	// Not enough energy, nothing was saved
+	if updatedData == nil {
+		return &pb.ConsumeEnergyResponse{
+			EnergyState: energyState,
+			Success:     false,
+			Message: fmt.Sprintf("Insufficient %s. Required: %d, Available: %d",
+				poolId, req.Amount, energyState.CurrentEnergy),
+		}, nil
+	}
+
+	return &pb.ConsumeEnergyResponse{
+		EnergyState: energyState,
+		Success:     true,
+		Message:     fmt.Sprintf("Consumed %d %s for %s", req.Amount, poolId, req.ActionType),
+		Pools:       []*pb.EnergyState{energyState},
+	}, nil
+}
+
+// RefillEnergy adds energy to the player's pool (admin)
+func (s *EnergyServiceServerImpl) RefillEnergy(
+	ctx context.Context, req *pb.RefillEnergyRequest,
+) (*pb.RefillEnergyResponse, error) {
+	// Validate amount
+	if req.Amount <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Amount must be positive")
+	}
+
+	economyConfig := s.economy.Current()
+	poolId := poolIdOrDefault(req.PoolId)
+	if err := validatePool(economyConfig, poolId); err != nil {
+		return nil, err
+	}
+
+	var response *pb.RefillEnergyResponse
+
+	entry := &storage.LedgerEntry{
+		Actor:         ledgerActorAdmin,
+		Source:        req.Source,
+		TransactionId: req.TransactionId,
+	}
+	_, err := s.updateEnergyData(ctx, req.Namespace, req.UserId, entry,
+		func(data *storage.EnergyData, _ *pb.EnergyState, now int64) (bool, error) {
+			// A retried transaction gets the original response without refilling again
+			replayed, err := findRefillTransaction(data, req.TransactionId, req.Source, poolId, req.ItemId)
+			if err != nil || replayed != nil {
+				response = replayed
+				return false, err
+			}
+
+			poolState := calculatePoolState(economyConfig, data, poolId, now)
+			capacity := refillCapacity(economyConfig, req.Source, poolState)
+			refillPoolEnergy(economyConfig, data, poolId, req.Amount, capacity, now)
+
+			energyState := calculatePoolState(economyConfig, data, poolId, now)
+			response = &pb.RefillEnergyResponse{
+				EnergyState: energyState,
+				Success:     true,
+				Message:     fmt.Sprintf("Refilled %d %s from %s", req.Amount, poolId, req.Source),
+				Pools:       []*pb.EnergyState{energyState},
+			}
+
+			return true, recordRefillTransaction(data, req.TransactionId, req.Source, poolId, req.ItemId, response, false, now)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetEnergyConfig returns the player's energy configuration (admin)
+func (s *EnergyServiceServerImpl) GetEnergyConfig(
+	ctx context.Context, req *pb.GetEnergyConfigRequest,
+) (*pb.GetEnergyConfigResponse, error) {
+	data, err := s.storage.GetEnergyData(ctx, req.Namespace, req.UserId)
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return nil, err
+	}
+
+	// If no data exists, return the namespace defaults
+	if data == nil {
+		defaults, err := s.getNamespaceConfig(ctx, req.Namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		return &pb.GetEnergyConfigResponse{
+			Config: &pb.EnergyConfig{
+				UserId:           req.UserId,
+				MaxEnergy:        defaults.MaxEnergy,
+				RegenRateSeconds: defaults.RegenRateSeconds,
+				Level:            storage.DefaultLevel,
+			},
+		}, nil
+	}
+
+	return &pb.GetEnergyConfigResponse{
+		Config: toEnergyConfig(s.economy.Current(), data),
+	}, nil
+}
+
+// UpdateEnergyConfig updates the player's energy configuration (admin)
+func (s *EnergyServiceServerImpl) UpdateEnergyConfig(
+	ctx context.Context, req *pb.UpdateEnergyConfigRequest,
+) (*pb.UpdateEnergyConfigResponse, error) {
+	economyConfig := s.economy.Current()
+
+	entry := &storage.LedgerEntry{Actor: ledgerActorAdmin}
+	updatedData, err := s.updateEnergyData(ctx, req.Namespace, req.UserId, entry,
+		func(data *storage.EnergyData, _ *pb.EnergyState, now int64) (bool, error) {
+			// Bank regenerated energy before the rate or cap changes
+			changeEnergyLimits(economyConfig, data, now, func() {
+				if req.ClearOverrides {
+					data.MaxEnergyOverride = 0
+					data.RegenRateSecondsOverride = 0
+				}
+
+				// Apply updates as per-user overrides of the level (0 = no change)
+				if req.MaxEnergy > 0 {
+					data.MaxEnergyOverride = req.MaxEnergy
+				}
+				if req.RegenRateSeconds > 0 {
+					data.RegenRateSecondsOverride = req.RegenRateSeconds
+				}
+			})
+
+			return true, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateEnergyConfigResponse{
+		Config:  toEnergyConfig(economyConfig, updatedData),
+		Success: true,
+		Message: "Energy configuration updated",
+	}, nil
+}
+
+// SetEnergyLevel moves a player to an energy level without spending items (admin)
+func (s *EnergyServiceServerImpl) SetEnergyLevel(
+	ctx context.Context, req *pb.SetEnergyLevelRequest,
+) (*pb.SetEnergyLevelResponse, error) {
+	economyConfig := s.economy.Current()
+
+	if req.Level < 1 || req.Level > economyConfig.MaxLevel() {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Level must be between 1 and %d", economyConfig.MaxLevel())
+	}
+
+	var energyState *pb.EnergyState
+
+	entry := &storage.LedgerEntry{Actor: ledgerActorAdmin}
+	updatedData, err := s.updateEnergyData(ctx, req.Namespace, req.UserId, entry,
+		func(data *storage.EnergyData, _ *pb.EnergyState, now int64) (bool, error) {
+			setEnergyLevel(economyConfig, data, req.Level, now)
+			energyState = calculatePoolState(economyConfig, data, economy.DefaultPoolID, now)
+
+			return true, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SetEnergyLevelResponse{
+		EnergyState: energyState,
+		Config:      toEnergyConfig(economyConfig, updatedData),
+		Success:     true,
+		Message:     fmt.Sprintf("Energy level set to %d", req.Level),
+	}, nil
+}
+
+// ResetEnergy resets a player's energy state to defaults (admin only)
+func (s *EnergyServiceServerImpl) ResetEnergy(
+	ctx context.Context, req *pb.ResetEnergyRequest,
+) (*pb.ResetEnergyResponse, error) {
+	defaults, err := s.getNamespaceConfig(ctx, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	economyConfig := s.economy.Current()
+	var newState *pb.EnergyState
+
+	entry := &storage.LedgerEntry{Actor: ledgerActorAdmin}
+	_, err = s.updateEnergyData(ctx, req.Namespace, req.UserId, entry,
+		func(data *storage.EnergyData, _ *pb.EnergyState, now int64) (bool, error) {
+			// Reset energy fields to the namespace defaults, inventory is kept unless explicitly wiped.
+			// Refill usage is kept, so a reset does not grant extra refills past their limits.
+			setPoolEnergy(data, economy.DefaultPoolID, defaults.StartingEnergy, now, 0)
+			data.MaxEnergy = defaults.MaxEnergy
+			data.RegenRateSeconds = defaults.RegenRateSeconds
+			data.Level = storage.DefaultLevel
+			data.MaxEnergyOverride = 0
+			data.RegenRateSecondsOverride = 0
+			data.Pools = nil
+
+			// Open holds are refunded into the reset pools like expired ones
+			entry.ActionId = strings.Join(releaseAllHolds(economyConfig, data, now), ",")
+
+			if req.WipeInventory {
+				data.Inventory = nil
+				data.PendingItems = nil
+			}
+
+			newState = calculatePoolState(economyConfig, data, economy.DefaultPoolID, now)
+
+			return true, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	message := "Energy state reset to defaults, inventory kept"
+	if req.WipeInventory {
+		message = "Energy state reset to defaults, inventory wiped"
+	}
+
+	return &pb.ResetEnergyResponse{
+		EnergyState: newState,
+		Success:     true,
+		Message:     message,
+	}, nil
+}
+
+// GrantItems adds catalog items to a player's inventory (admin)
+func (s *EnergyServiceServerImpl) GrantItems(
+	ctx context.Context, req *pb.GrantItemsRequest,
+) (*pb.UpdateInventoryResponse, error) {
+	economyConfig := s.economy.Current()
+
+	quantities, err := itemQuantities(economyConfig, req.Items, true)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &storage.LedgerEntry{Actor: ledgerActorAdmin, Reason: req.Reason}
+	updatedData, err := s.updateEnergyData(ctx, req.Namespace, req.UserId, entry,
+		func(data *storage.EnergyData, _ *pb.EnergyState, _ int64) (bool, error) {
+			return true, grantItems(economyConfig, data, quantities)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateInventoryResponse{
+		Items:   toInventoryItems(economyConfig, updatedData.Inventory, ""),
+		Success: true,
+		Message: fmt.Sprintf("Granted %s", formatItemQuantities(quantities)),
+	}, nil
+}
+
+// RevokeItems removes items from a player's inventory, all or none (admin)
+func (s *EnergyServiceServerImpl) RevokeItems(
+	ctx context.Context, req *pb.RevokeItemsRequest,
+) (*pb.UpdateInventoryResponse, error) {
+	entry := &storage.LedgerEntry{Actor: ledgerActorAdmin, Reason: req.Reason}
+
+	return s.removeItems(ctx, req.Namespace, req.UserId, req.Items, entry)
+}
+
+// ListEnergyTransactions returns the ledger of a player, newest first (admin)
+func (s *EnergyServiceServerImpl) ListEnergyTransactions(
+	ctx context.Context, req *pb.ListEnergyTransactionsRequest,
+) (*pb.ListEnergyHistoryResponse, error) {
+	return s.listLedgerEntries(ctx, req.Namespace, req.UserId, req.StartTime, req.EndTime, req.PageSize, req.Cursor)
+}
+
+// GetNamespaceEnergyConfig returns the defaults for new players in the namespace (admin)
+func (s *EnergyServiceServerImpl) GetNamespaceEnergyConfig(
+	ctx context.Context, req *pb.GetNamespaceEnergyConfigRequest,
+) (*pb.GetNamespaceEnergyConfigResponse, error) {
+	config, err := s.getNamespaceConfig(ctx, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetNamespaceEnergyConfigResponse{
+		Config: toNamespaceEnergyConfig(req.Namespace, config),
+	}, nil
+}
+
+// UpdateNamespaceEnergyConfig updates the defaults for new players in the namespace (admin)
+func (s *EnergyServiceServerImpl) UpdateNamespaceEnergyConfig(
+	ctx context.Context, req *pb.UpdateNamespaceEnergyConfigRequest,
+) (*pb.UpdateNamespaceEnergyConfigResponse, error) {
+	if req.MaxEnergy < 0 || req.RegenRateSeconds < 0 || req.StartingEnergy < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Config values must not be negative")
+	}
+
+	config, err := s.getNamespaceConfig(ctx, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply updates (0 = no change)
+	if req.MaxEnergy > 0 {
+		config.MaxEnergy = req.MaxEnergy
+	}
+	if req.RegenRateSeconds > 0 {
+		config.RegenRateSeconds = req.RegenRateSeconds
+	}
+	if req.StartingEnergy > 0 {
+		config.StartingEnergy = req.StartingEnergy
+	}
+
+	if config.StartingEnergy > config.MaxEnergy {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Starting energy %d must not exceed max energy %d", config.StartingEnergy, config.MaxEnergy)
+	}
+
+	if err := s.storage.SaveNamespaceConfig(ctx, req.Namespace, config); err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateNamespaceEnergyConfigResponse{
+		Config:  toNamespaceEnergyConfig(req.Namespace, config),
+		Success: true,
+		Message: "Namespace energy configuration updated",
+	}, nil
+}
+
+// CreateRegenBoost creates a regen boost for a player, or a namespace-wide event without a player (admin)
+func (s *EnergyServiceServerImpl) CreateRegenBoost(
+	ctx context.Context, req *pb.CreateRegenBoostRequest,
+) (*pb.RegenBoostResponse, error) {
+	now, err := s.now(ctx, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.MultiplierPercent <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Multiplier percent must be positive")
+	}
+	if req.DurationSeconds <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Duration must be positive")
+	}
+	if req.PoolId != "" {
+		if err := validatePool(s.economy.Current(), req.PoolId); err != nil {
+			return nil, err
+		}
+	}
+
+	// Regen that already happened is banked, so a boost cannot start in the past
+	startTime := req.StartTime
+	if startTime == 0 {
+		startTime = now.Unix()
+	}
+	if startTime < now.Unix() {
+		return nil, status.Errorf(codes.InvalidArgument, "Start time must not be in the past")
+	}
+
+	boost := storage.RegenBoost{
+		BoostId:           uuid.NewString(),
+		MultiplierPercent: req.MultiplierPercent,
+		PoolId:            req.PoolId,
+		StartTime:         startTime,
+		EndTime:           startTime + req.DurationSeconds,
+		Reason:            req.Reason,
+	}
+
+	if req.UserId == "" {
+		boosts, err := s.storage.GetNamespaceBoosts(ctx, req.Namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		// Ended events are kept for players who were offline during them, up to the retention
+		boosts = append(pruneNamespaceBoosts(boosts, now), boost)
+		if err := s.storage.SaveNamespaceBoosts(ctx, req.Namespace, boosts); err != nil {
+			return nil, err
+		}
+		s.namespaceBoosts.set(req.Namespace, boosts)
+	} else {
+		entry := &storage.LedgerEntry{Actor: ledgerActorAdmin, ActionId: boost.BoostId}
+		_, err := s.updateEnergyData(ctx, req.Namespace, req.UserId, entry,
+			func(data *storage.EnergyData, _ *pb.EnergyState, _ int64) (bool, error) {
+				pruneRegenBoosts(data)
+				data.RegenBoosts = append(data.RegenBoosts, boost)
+
+				return true, nil
+			})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.RegenBoostResponse{
+		Boost:   toRegenBoost(req.UserId, boost),
+		Success: true,
+		Message: fmt.Sprintf("Regen boost of %d%% created", boost.MultiplierPercent),
+	}, nil
+}
+
+// ListRegenBoosts lists the active and upcoming regen boosts of a player, including the
+// namespace-wide events, or only the namespace-wide events without a player (admin)
+func (s *EnergyServiceServerImpl) ListRegenBoosts(
+	ctx context.Context, req *pb.ListRegenBoostsRequest,
+) (*pb.ListRegenBoostsResponse, error) {
+	now, err := s.now(ctx, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	var boosts []*pb.RegenBoost
+
+	if req.UserId != "" {
+		data, err := s.storage.GetEnergyData(ctx, req.Namespace, req.UserId)
+		if err != nil && !errors.Is(err, storage.ErrNotFound) {
+			return nil, err
+		}
+
+		if data != nil {
+			for _, boost := range data.RegenBoosts {
+				if boost.EndTime > now.Unix() {
+					boosts = append(boosts, toRegenBoost(req.UserId, boost))
+				}
+			}
+		}
+	}
+
+	namespaceBoosts, err := s.storage.GetNamespaceBoosts(ctx, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	for _, boost := range namespaceBoosts {
+		if boost.EndTime > now.Unix() {
+			boosts = append(boosts, toRegenBoost("", boost))
+		}
+	}
+
+	return &pb.ListRegenBoostsResponse{Boosts: boosts}, nil
+}
+
+// CancelRegenBoost ends a regen boost of a player, or a namespace-wide event without a player (admin)
+func (s *EnergyServiceServerImpl) CancelRegenBoost(
+	ctx context.Context, req *pb.CancelRegenBoostRequest,
+) (*pb.RegenBoostResponse, error) {
+	now, err := s.now(ctx, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	var cancelled *storage.RegenBoost
+
+	if req.UserId == "" {
+		boosts, err := s.storage.GetNamespaceBoosts(ctx, req.Namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		boosts, cancelled, err = cancelRegenBoost(boosts, req.BoostId, now.Unix())
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.storage.SaveNamespaceBoosts(ctx, req.Namespace, boosts); err != nil {
+			return nil, err
+		}
+		s.namespaceBoosts.set(req.Namespace, boosts)
+	} else {
+		entry := &storage.LedgerEntry{Actor: ledgerActorAdmin, ActionId: req.BoostId}
+		_, err := s.updateEnergyData(ctx, req.Namespace, req.UserId, entry,
+			func(data *storage.EnergyData, _ *pb.EnergyState, _ int64) (bool, error) {
+				var err error
+				data.RegenBoosts, cancelled, err = cancelRegenBoost(data.RegenBoosts, req.BoostId, now.Unix())
+
+				return err == nil, err
+			})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.RegenBoostResponse{
+		Boost:   toRegenBoost(req.UserId, *cancelled),
+		Success: true,
+		Message: "Regen boost cancelled",
+	}, nil
+}
+
+// GetDebugTimeOffset returns how far the clock of a namespace runs ahead (admin)
+func (s *EnergyServiceServerImpl) GetDebugTimeOffset(
+	ctx context.Context, req *pb.GetDebugTimeOffsetRequest,
+) (*pb.DebugTimeOffsetResponse, error) {
+	offsetSeconds, err := s.storage.GetDebugTimeOffset(ctx, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toDebugTimeOffsetResponse(req.Namespace, offsetSeconds), nil
+}
+
+// SetDebugTimeOffset moves the clock of a namespace ahead so QA can fast-forward regeneration (admin)
+func (s *EnergyServiceServerImpl) SetDebugTimeOffset(
+	ctx context.Context, req *pb.SetDebugTimeOffsetRequest,
+) (*pb.DebugTimeOffsetResponse, error) {
+	if !s.debugTimeOffsetEnabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "Debug time offsets are disabled on this service")
+	}
+	if req.OffsetSeconds < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Offset must not be negative")
+	}
+
+	if err := s.storage.SaveDebugTimeOffset(ctx, req.Namespace, req.OffsetSeconds); err != nil {
+		return nil, err
+	}
+	s.debugTimeOffsets.set(req.Namespace, req.OffsetSeconds)
+
+	return s.toDebugTimeOffsetResponse(req.Namespace, req.OffsetSeconds), nil
+}
+
+// ============== Helper Methods ==============
+
+// tokenClaims are the JWT claims the service reads
+type tokenClaims struct {
+	Sub      string `json:"sub"`       // User ID, empty for client tokens
+	ClientId string `json:"client_id"` // IAM client the token was issued to
+}
+
+// parseTokenClaims decodes the claims of the JWT token in the context.
+// The signature is checked by the auth interceptor.
+func parseTokenClaims(ctx context.Context) (*tokenClaims, error) {
+	meta, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing metadata")
+	}
+
+	authHeader := meta.Get("authorization")
+	if len(authHeader) == 0 {
+		return nil, status.Errorf(codes.Unauthenticated, "missing authorization header")
+	}
+
+	token := strings.TrimPrefix(authHeader[0], "Bearer ")
+
+	// JWT has 3 parts: header.payload.signature
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid token format")
+	}
+
+	// Decode payload (middle part)
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to decode token payload")
+	}
+
+	var claims tokenClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to parse token claims")
+	}
+
+	return &claims, nil
+}
+
+// extractUserIdFromToken extracts the user ID from the JWT token in the context
+func (s *EnergyServiceServerImpl) extractUserIdFromToken(ctx context.Context) (string, error) {
+	claims, err := parseTokenClaims(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if claims.Sub == "" {
+		return "", status.Errorf(codes.Unauthenticated, "user ID not found in token")
+	}
+
+	return claims.Sub, nil
+}
+
+// getEnergy gets existing energy or creates default for new players, with the state of every pool
+func (s *EnergyServiceServerImpl) getEnergy(
+	ctx context.Context, namespace string, userId string, poolId string,
+) (*pb.GetEnergyResponse, error) {
+	economyConfig := s.economy.Current()
+	poolId = poolIdOrDefault(poolId)
+	if err := validatePool(economyConfig, poolId); err != nil {
+		return nil, err
+	}
+
+	data, now, err := s.currentEnergyData(ctx, namespace, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Calculate current energy with regeneration
+	return &pb.GetEnergyResponse{
+		EnergyState: calculatePoolState(economyConfig, data, poolId, now),
+		Pools:       calculatePoolStates(economyConfig, data, economyConfig.PoolIDs(), now),
+	}, nil
+}
+
+// currentEnergyData gets the player's energy data to show, with expired holds refunded,
+// and the Unix milliseconds it is current at
+func (s *EnergyServiceServerImpl) currentEnergyData(
+	ctx context.Context, namespace string, userId string,
+) (*storage.EnergyData, int64, error) {
+	now, err := s.now(ctx, namespace)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	data, err := s.getOrCreateEnergyData(ctx, namespace, userId, now.UnixMilli())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Refund expired holds before showing the state, updateEnergyData releases them
+	if hasExpiredHolds(data, now.UnixMilli()) {
+		data, err = s.updateEnergyData(ctx, namespace, userId, nil,
+			func(_ *storage.EnergyData, _ *pb.EnergyState, _ int64) (bool, error) {
+				return true, nil
+			})
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return data, now.UnixMilli(), nil
+}
+
+// getOrCreateEnergyData gets the stored energy data or saves the defaults for new players,
+// now being Unix milliseconds
+func (s *EnergyServiceServerImpl) getOrCreateEnergyData(
+	ctx context.Context, namespace string, userId string, now int64,
+) (*storage.EnergyData, error) {
+	// Every regen calculation needs the namespace-wide regen events
+	namespaceBoosts, err := s.namespaceBoosts.get(ctx, namespace)
+	if err != nil {
+		return nil, status.Errorf(status.Code(err), "Failed to get namespace boosts: %v", err)
+	}
+
+	data, err := s.storage.GetEnergyData(ctx, namespace, userId)
+	if err == nil {
+		data.NamespaceBoosts = namespaceBoosts
+		return data, nil
+	}
+
+	// Only initialize defaults when storage confirmed the record does not exist,
+	// any other failure must not overwrite the player's real data
+	if !errors.Is(err, storage.ErrNotFound) {
+		return nil, status.Errorf(status.Code(err), "Failed to get energy data: %v", err)
+	}
+
+	// New player - create default energy state from the namespace config
+	defaults, err := s.getNamespaceConfig(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	data = &storage.EnergyData{
+		UserId:           userId,
+		CurrentEnergy:    defaults.StartingEnergy,
+		MaxEnergy:        defaults.MaxEnergy,
+		LastUpdateTime:   now / 1000,
+		LastUpdateTimeMs: now,
+		RegenRateSeconds: defaults.RegenRateSeconds,
+		Level:            storage.DefaultLevel,
+	}
+
+	// Save the initial state, another request may have created the record in the meantime
+	saved, err := s.storage.SaveEnergyData(ctx, namespace, userId, data)
+	if errors.Is(err, storage.ErrConflict) {
+		saved, err = s.storage.GetEnergyData(ctx, namespace, userId)
+		if err != nil {
+			return nil, status.Errorf(status.Code(err), "Failed to get energy data: %v", err)
+		}
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to initialize energy: %v", err)
+	}
+	saved.NamespaceBoosts = namespaceBoosts
+
+	return saved, nil
+}
+
+// now returns the current time of a namespace: the service clock, moved ahead by the
+// namespace debug time offset when debug time offsets are enabled
+func (s *EnergyServiceServerImpl) now(ctx context.Context, namespace string) (time.Time, error) {
+	now := s.clock.Now()
+	if !s.debugTimeOffsetEnabled {
+		return now, nil
+	}
+
+	offsetSeconds, err := s.debugTimeOffsets.get(ctx, namespace)
+	if err != nil {
+		return time.Time{}, status.Errorf(status.Code(err), "Failed to get debug time offset: %v", err)
+	}
+
+	return now.Add(time.Duration(offsetSeconds) * time.Second), nil
+}
+
+// getNamespaceConfig returns the namespace defaults for new players,
+// or the built-in defaults when the namespace has no stored config
+func (s *EnergyServiceServerImpl) getNamespaceConfig(
+	ctx context.Context, namespace string,
+) (*storage.NamespaceConfig, error) {
+	config, err := s.storage.GetNamespaceConfig(ctx, namespace)
+	if errors.Is(err, storage.ErrNotFound) {
+		return storage.DefaultNamespaceConfig(), nil
+	}
+	if err != nil {
+		return nil, status.Errorf(status.Code(err), "Failed to get namespace config: %v", err)
+	}
+
+	return config, nil
+}
+
+// cancelRegenBoost ends the boost now, or removes it if it has not started yet.
+// Ending it rather than removing it keeps the regen earned while it was active.
+func cancelRegenBoost(
+	boosts []storage.RegenBoost, boostId string, now int64,
+) ([]storage.RegenBoost, *storage.RegenBoost, error) {
+	for i, boost := range boosts {
+		if boost.BoostId != boostId {
+			continue
+		}
+
+		if boost.EndTime <= now {
+			return nil, nil, status.Errorf(codes.FailedPrecondition, "Regen boost %s has already ended", boostId)
+		}
+
+		if boost.StartTime >= now {
+			boosts = append(boosts[:i], boosts[i+1:]...)
+		} else {
+			boost.EndTime = now
+			boosts[i] = boost
+		}
+
+		return boosts, &boost, nil
+	}
+
+	return nil, nil, status.Errorf(codes.NotFound, "Regen boost %s not found", boostId)
+}
+
+// toRegenBoost converts a stored regen boost to its API representation
+func toRegenBoost(userId string, boost storage.RegenBoost) *pb.RegenBoost {
+	return &pb.RegenBoost{
+		BoostId:           boost.BoostId,
+		UserId:            userId,
+		MultiplierPercent: boost.MultiplierPercent,
+		PoolId:            boost.PoolId,
+		StartTime:         boost.StartTime,
+		EndTime:           boost.EndTime,
+		Reason:            boost.Reason,
+	}
+}
+
+// toDebugTimeOffsetResponse describes the debug time offset of a namespace
+func (s *EnergyServiceServerImpl) toDebugTimeOffsetResponse(namespace string, offsetSeconds int64) *pb.DebugTimeOffsetResponse {
+	serverTime := s.clock.Now().Unix()
+	effectiveTime := serverTime
+	if s.debugTimeOffsetEnabled {
+		effectiveTime += offsetSeconds
+	}
+
+	return &pb.DebugTimeOffsetResponse{
+		Namespace:     namespace,
+		OffsetSeconds: offsetSeconds,
+		ServerTime:    serverTime,
+		EffectiveTime: effectiveTime,
+		Enabled:       s.debugTimeOffsetEnabled,
+	}
+}
+
+// toNamespaceEnergyConfig converts a stored namespace config to its API representation
+func toNamespaceEnergyConfig(namespace string, config *storage.NamespaceConfig) *pb.NamespaceEnergyConfig {
+	return &pb.NamespaceEnergyConfig{
+		Namespace:        namespace,
+		MaxEnergy:        config.MaxEnergy,
+		RegenRateSeconds: config.RegenRateSeconds,
+		StartingEnergy:   config.StartingEnergy,
+	}
+}
+
+// updateEnergyData runs a read-modify-write cycle on the player's energy data.
+// mutate receives the stored data with expired holds refunded, its regenerated state and the
+// namespace time in Unix milliseconds, and changes only the fields it means to update, every
+// other field (inventory, level, ...) is saved as read.
+// Returning false leaves the record untouched and updateEnergyData returns nil data.
+// When the record was changed concurrently the cycle is retried on freshly read data,
+// so mutate must re-validate against the new state each time.
+// A saved change of the energy or inventory is recorded in the ledger as entry, with the changes
+// filled in. mutate may complete entry, e.g. with an ID it generates. A nil entry records
+// only the refund of expired holds.
+func (s *EnergyServiceServerImpl) updateEnergyData(
+	ctx context.Context, namespace string, userId string, entry *storage.LedgerEntry,
+	mutate func(data *storage.EnergyData, state *pb.EnergyState, now int64) (bool, error),
+) (*storage.EnergyData, error) {
+	economyConfig := s.economy.Current()
+
+	for attempt := 1; ; attempt++ {
+		now, err := s.now(ctx, namespace)
+		if err != nil {
+			return nil, err
+		}
+		nowMs := now.UnixMilli()
+
+		// The freshly read data carries its UpdatedAt, so the save only succeeds
+		// if the record is still the version we read
+		data, err := s.getOrCreateEnergyData(ctx, namespace, userId, nowMs)
+		if err != nil {
+			return nil, err
+		}
+
+		// Entries of earlier changes are dropped from the record once the ledger has them
+		pending := s.flushedLedger.unflushed(namespace, userId, data.PendingLedgerEntries)
+		if s.flushLedgerEntries(ctx, namespace, userId, pending) {
+			pending = nil
+		}
+		data.PendingLedgerEntries = pending
+
+		var entries []storage.LedgerEntry
+		before := takeLedgerSnapshot(economyConfig, data, nowMs)
+
+		// Holds nobody committed or released in time are refunded by the next change
+		if hasExpiredHolds(data, nowMs) {
+			expired := expiredHoldsEntry(data, nowMs)
+			releaseExpiredHolds(economyConfig, data, nowMs)
+
+			after := takeLedgerSnapshot(economyConfig, data, nowMs)
+			if setLedgerChanges(economyConfig, &expired, before, after) {
+				entries = append(entries, expired)
+			}
+			before = after
+		}
+
+		save, err := mutate(data, s.calculateEnergyState(data, nowMs), nowMs)
+		if err != nil {
+			return nil, err
+		}
+		if !save {
+			return nil, nil
+		}
+
+		if entry != nil {
+			recorded := newLedgerEntry(ctx, entry, nowMs)
+			if setLedgerChanges(economyConfig, &recorded, before, takeLedgerSnapshot(economyConfig, data, nowMs)) {
+				entries = append(entries, recorded)
+			}
+		}
+		s.queueLedgerEntries(data, entries)
+
+		_, err = s.storage.SaveEnergyData(ctx, namespace, userId, data)
+		if errors.Is(err, storage.ErrConflict) {
+			if attempt < maxSaveAttempts {
+				continue
+			}
+
+			return nil, status.Errorf(codes.Aborted, "Energy data is being modified concurrently, please retry")
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to save energy data: %v", err)
+		}
+		s.flushLedgerEntries(ctx, namespace, userId, data.PendingLedgerEntries)
+
+		return data, nil
+	}
+}
+
+// calculateEnergyState applies time-based regeneration to the default pool at the limits of the player's level
+func (s *EnergyServiceServerImpl) calculateEnergyState(data *storage.EnergyData, now int64) *pb.EnergyState {
+	return calculatePoolState(s.economy.Current(), data, economy.DefaultPoolID, now)
 }
@@ -0,0 +1,169 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"extend-custom-guild-service/pkg/economy"
+	pb "extend-custom-guild-service/pkg/pb"
+	"extend-custom-guild-service/pkg/storage"
+)
+
+// interleavedStorage lets another writer change the player's record right before
+// each of the next writes saves of the service
+type interleavedStorage struct {
+	*storage.MemoryStorage
+	writes int
+}
+
+func (m *interleavedStorage) SaveEnergyData(
+	ctx context.Context, namespace string, userId string, data *storage.EnergyData,
+) (*storage.EnergyData, error) {
+	if m.writes > 0 && !data.UpdatedAt.IsZero() {
+		m.writes--
+
+		// The other writer grants a gold on the version the service read
+		stored, err := m.MemoryStorage.GetEnergyData(ctx, namespace, userId)
+		if err != nil {
+			return nil, err
+		}
+		if stored.Inventory == nil {
+			stored.Inventory = make(map[string]int32)
+		}
+		stored.Inventory["gold"]++
+		if _, err := m.MemoryStorage.SaveEnergyData(ctx, namespace, userId, stored); err != nil {
+			return nil, err
+		}
+	}
+
+	return m.MemoryStorage.SaveEnergyData(ctx, namespace, userId, data)
+}
+
+func TestUpdateEnergyDataConflicts(t *testing.T) {
+	tests := []struct {
+		name         string
+		writes       int // Concurrent writes before the service saves
+		wantAttempts int
+		wantCode     codes.Code
+		wantHerb     int32 // Herbs the service granted
+	}{
+		{
+			name:         "retries on the freshly read record",
+			writes:       1,
+			wantAttempts: 2,
+			wantHerb:     1,
+		},
+		{
+			name:         "gives up after the last attempt",
+			writes:       maxSaveAttempts,
+			wantAttempts: maxSaveAttempts,
+			wantCode:     codes.Aborted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &interleavedStorage{MemoryStorage: storage.NewMemoryStorage()}
+			s := NewEnergyServiceServer(nil, nil, nil, store, economy.NewStaticProvider(loadTestConfig(t)),
+				WithClock(NewFakeClock(testStart)))
+
+			// Create the player before anyone writes concurrently
+			if _, err := s.GetMyEnergy(context.Background(), &pb.GetMyEnergyRequest{
+				Namespace: testNamespace, UserId: testUserId,
+			}); err != nil {
+				t.Fatalf("get energy: %v", err)
+			}
+			store.writes = tt.writes
+
+			var goldSeen []int32
+			_, err := s.updateEnergyData(context.Background(), testNamespace, testUserId, nil,
+				func(data *storage.EnergyData, _ *pb.EnergyState, _ int64) (bool, error) {
+					goldSeen = append(goldSeen, data.Inventory["gold"])
+					if data.Inventory == nil {
+						data.Inventory = make(map[string]int32)
+					}
+					data.Inventory["herb"]++
+
+					return true, nil
+				})
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("updateEnergyData error = %v, want %s", err, tt.wantCode)
+			}
+
+			// Every attempt starts from the record the other writer left
+			if len(goldSeen) != tt.wantAttempts {
+				t.Fatalf("attempts = %d, want %d", len(goldSeen), tt.wantAttempts)
+			}
+			for attempt, gold := range goldSeen {
+				if gold != int32(attempt) {
+					t.Errorf("attempt %d read %d gold, want %d", attempt+1, gold, attempt)
+				}
+			}
+
+			data, err := store.GetEnergyData(context.Background(), testNamespace, testUserId)
+			if err != nil {
+				t.Fatalf("get energy data: %v", err)
+			}
+			if data.Inventory["gold"] != int32(tt.writes) || data.Inventory["herb"] != tt.wantHerb {
+				t.Errorf("gold, herb = %d, %d, want %d, %d",
+					data.Inventory["gold"], data.Inventory["herb"], tt.writes, tt.wantHerb)
+			}
+		})
+	}
+}
+
+func TestResetEnergy(t *testing.T) {
+	s, store, _ := newTestServer(t)
+	ctx := context.Background()
+
+	// New and reset players start with 50 of the 100 energy
+	if _, err := s.UpdateNamespaceEnergyConfig(ctx, &pb.UpdateNamespaceEnergyConfigRequest{
+		Namespace: testNamespace, StartingEnergy: 50,
+	}); err != nil {
+		t.Fatalf("update namespace energy config: %v", err)
+	}
+
+	// The daily refill may be used once a day
+	fight()(t, s, nil)
+	refillResp, err := s.RefillMyEnergy(ctx, &pb.RefillMyEnergyRequest{
+		Namespace: testNamespace, UserId: testUserId, Source: "daily",
+	})
+	if err != nil || !refillResp.Success {
+		t.Fatalf("daily refill: %v %v", refillResp, err)
+	}
+
+	reserve(t, s, "dungeon", time.Minute)
+
+	if _, err := s.ResetEnergy(ctx, &pb.ResetEnergyRequest{Namespace: testNamespace, UserId: testUserId}); err != nil {
+		t.Fatalf("reset energy: %v", err)
+	}
+
+	// The held dungeon cost is refunded on top of the reset energy
+	pools := currentPools(t, s)
+	if pools["energy"] != 65 || pools["keys"] != 3 {
+		t.Errorf("energy, keys = %d, %d, want 65, 3", pools["energy"], pools["keys"])
+	}
+	data, err := store.GetEnergyData(ctx, testNamespace, testUserId)
+	if err != nil {
+		t.Fatalf("get energy data: %v", err)
+	}
+	if len(data.Holds) > 0 {
+		t.Errorf("holds were kept: %v", data.Holds)
+	}
+
+	// The reset does not grant another daily refill
+	refillResp, err = s.RefillMyEnergy(ctx, &pb.RefillMyEnergyRequest{
+		Namespace: testNamespace, UserId: testUserId, Source: "daily",
+	})
+	if err != nil || refillResp.Success {
+		t.Errorf("daily refill after reset = %v, %v, want unsuccessful", refillResp, err)
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"extend-custom-guild-service/pkg/economy"
+	pb "extend-custom-guild-service/pkg/pb"
+	"extend-custom-guild-service/pkg/storage"
+	"fmt"
+	"time"
+)
+
+// exchangeUsageKey returns the key an offer's uses are tracked under in the player's refill usage
+func exchangeUsageKey(offerId string) string {
+	return economy.ExchangeSource + ":" + offerId
+}
+
+// exchangeForEnergy spends the offer's price and credits its energy, up to max energy unless
+// the exchange source may overflow. Nothing is changed on data when the offer is not available
+// yet, the player is short of an item or no pool has room for more energy.
+func exchangeForEnergy(
+	config *economy.Config, data *storage.EnergyData, offerId string, offer economy.ExchangeOffer, now int64,
+) *pb.ExchangeForEnergyResponse {
+	nowTime := time.UnixMilli(now)
+	usageKey := exchangeUsageKey(offerId)
+	usage := refillUsage(offer.RefillPolicy, data, usageKey, nowTime)
+	pools := calculatePoolStates(config, data, offer.Energy.PoolIDs(), now)
+
+	response := &pb.ExchangeForEnergyResponse{
+		EnergyState: pools[0],
+		Success:     false,
+		Pools:       pools,
+	}
+
+	// Enforce the cooldown and daily limit of the offer
+	if nextRefillTime(offer.RefillPolicy, usage, nowTime) > 0 {
+		response.Message = fmt.Sprintf("Exchange offer %s is not available yet", offerId)
+		setExchangeAvailability(response, offer.RefillPolicy, usage, nowTime)
+
+		return response
+	}
+
+	if response.Shortfalls = itemShortfalls(data, offer.Price); len(response.Shortfalls) > 0 {
+		response.Message = shortfallMessage(response.Shortfalls)
+		setExchangeAvailability(response, offer.RefillPolicy, usage, nowTime)
+
+		return response
+	}
+
+	// Spending the price for no energy at all is never what the player wants
+	capacities := make(map[string]int32, len(pools))
+	full := true
+	for _, poolState := range pools {
+		capacities[poolState.PoolId] = refillCapacity(config, economy.ExchangeSource, poolState)
+		full = full && poolState.CurrentEnergy >= capacities[poolState.PoolId]
+	}
+	if full {
+		response.Message = fmt.Sprintf("Energy pool %s is already full", pools[0].PoolId)
+		setExchangeAvailability(response, offer.RefillPolicy, usage, nowTime)
+
+		return response
+	}
+
+	spendItems(data, offer.Price)
+	for _, poolState := range pools {
+		refillPoolEnergy(config, data, poolState.PoolId, offer.Energy[poolState.PoolId], capacities[poolState.PoolId], now)
+	}
+	recordRefillUse(offer.RefillPolicy, data, usageKey, nowTime)
+
+	pools = calculatePoolStates(config, data, offer.Energy.PoolIDs(), now)
+	response = &pb.ExchangeForEnergyResponse{
+		EnergyState: pools[0],
+		Success:     true,
+		Message:     fmt.Sprintf("Exchanged %s for %s", formatItemQuantities(offer.Price), formatPoolAmounts(offer.Energy)),
+		Pools:       pools,
+	}
+	setExchangeAvailability(response, offer.RefillPolicy, data.RefillUsage[usageKey], nowTime)
+
+	return response
+}
+
+// setExchangeAvailability fills in when the player can next use an offer
+func setExchangeAvailability(
+	response *pb.ExchangeForEnergyResponse, policy economy.RefillPolicy, usage storage.RefillUsage, now time.Time,
+) {
+	response.NextAvailableTime = nextRefillTime(policy, usage, now)
+	response.MaxUsesPerDay = policy.MaxPerDay
+	if policy.MaxPerDay > 0 {
+		response.UsesToday = usage.UsesToday
+	}
+}
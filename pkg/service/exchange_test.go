@@ -0,0 +1,120 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "extend-custom-guild-service/pkg/pb"
+)
+
+// 100 gold buys 10 energy up to 10 times a day, 1 gem buys 30 energy
+func TestExchangeForEnergy(t *testing.T) {
+	tests := []struct {
+		name          string
+		gold          int32
+		spent         int32 // Energy spent before the exchange
+		offerId       string
+		wantSuccess   bool
+		wantShortfall *pb.ItemShortfall
+		wantGold      int32
+		wantEnergy    int32
+	}{
+		{
+			name:        "spends the price for the energy",
+			gold:        150,
+			spent:       10,
+			offerId:     "gold_energy",
+			wantSuccess: true,
+			wantGold:    50,
+			wantEnergy:  100,
+		},
+		{
+			name:          "player cannot afford the price",
+			gold:          50,
+			spent:         10,
+			offerId:       "gold_energy",
+			wantShortfall: &pb.ItemShortfall{ItemId: "gold", Required: 100, Available: 50},
+			wantGold:      50,
+			wantEnergy:    90,
+		},
+		{
+			name:          "player holds none of the price item",
+			gold:          150,
+			spent:         10,
+			offerId:       "gem_energy",
+			wantShortfall: &pb.ItemShortfall{ItemId: "gem", Required: 1},
+			wantGold:      150,
+			wantEnergy:    90,
+		},
+		{
+			name:       "full pool keeps the price",
+			gold:       150,
+			offerId:    "gold_energy",
+			wantGold:   150,
+			wantEnergy: 100,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, store, _ := newTestServer(t)
+			ctx := context.Background()
+
+			if _, err := s.GrantItems(ctx, &pb.GrantItemsRequest{
+				Namespace: testNamespace, UserId: testUserId,
+				Items: []*pb.ItemQuantity{{ItemId: "gold", Quantity: tt.gold}},
+			}); err != nil {
+				t.Fatalf("grant gold: %v", err)
+			}
+			// An admin consume rolls no loot that could add gold
+			if tt.spent > 0 {
+				if _, err := s.ConsumeEnergy(ctx, &pb.ConsumeEnergyRequest{
+					Namespace: testNamespace, UserId: testUserId, Amount: tt.spent,
+				}); err != nil {
+					t.Fatalf("consume energy: %v", err)
+				}
+			}
+
+			resp, err := s.ExchangeForEnergy(ctx, &pb.ExchangeForEnergyRequest{
+				Namespace: testNamespace, UserId: testUserId, OfferId: tt.offerId,
+			})
+			if err != nil {
+				t.Fatalf("exchange: %v", err)
+			}
+			if resp.Success != tt.wantSuccess {
+				t.Errorf("Success = %v, want %v (%s)", resp.Success, tt.wantSuccess, resp.Message)
+			}
+			if tt.wantShortfall == nil && len(resp.Shortfalls) > 0 {
+				t.Errorf("Shortfalls = %v, want none", resp.Shortfalls)
+			}
+			if tt.wantShortfall != nil && (len(resp.Shortfalls) != 1 ||
+				resp.Shortfalls[0].ItemId != tt.wantShortfall.ItemId ||
+				resp.Shortfalls[0].Required != tt.wantShortfall.Required ||
+				resp.Shortfalls[0].Available != tt.wantShortfall.Available) {
+				t.Errorf("Shortfalls = %v, want %v", resp.Shortfalls, tt.wantShortfall)
+			}
+
+			data, err := store.GetEnergyData(ctx, testNamespace, testUserId)
+			if err != nil {
+				t.Fatalf("get energy data: %v", err)
+			}
+			if data.Inventory["gold"] != tt.wantGold || data.CurrentEnergy != tt.wantEnergy {
+				t.Errorf("gold, energy = %d, %d, want %d, %d",
+					data.Inventory["gold"], data.CurrentEnergy, tt.wantGold, tt.wantEnergy)
+			}
+
+			// Only a completed exchange counts towards the daily limit
+			wantUses := int32(0)
+			if tt.wantSuccess {
+				wantUses = 1
+			}
+			if resp.UsesToday != wantUses {
+				t.Errorf("UsesToday = %d, want %d", resp.UsesToday, wantUses)
+			}
+		})
+	}
+}
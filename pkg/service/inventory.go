@@ -0,0 +1,310 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"context"
+	"extend-custom-guild-service/pkg/economy"
+	pb "extend-custom-guild-service/pkg/pb"
+	"extend-custom-guild-service/pkg/storage"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Most different items one spend, grant or revoke may change
+const maxItemChanges = 50
+
+// itemQuantities validates the requested items and merges repeated item IDs.
+// Items to grant must be in the catalog, items to remove may have been dropped from it since.
+func itemQuantities(config *economy.Config, items []*pb.ItemQuantity, catalogOnly bool) (map[string]int32, error) {
+	if len(items) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "At least one item is required")
+	}
+
+	quantities := make(map[string]int32, len(items))
+	for _, item := range items {
+		if item.ItemId == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "Item ID is required")
+		}
+		if _, known := config.Item(item.ItemId); catalogOnly && !known {
+			return nil, status.Errorf(codes.InvalidArgument, "Unknown item: %s", item.ItemId)
+		}
+		if item.Quantity <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Quantity of %s must be positive", item.ItemId)
+		}
+		if item.Quantity > math.MaxInt32-quantities[item.ItemId] {
+			return nil, status.Errorf(codes.InvalidArgument, "Quantity of %s is too large", item.ItemId)
+		}
+
+		quantities[item.ItemId] += item.Quantity
+	}
+	if len(quantities) > maxItemChanges {
+		return nil, status.Errorf(codes.InvalidArgument, "At most %d different items may be changed at once", maxItemChanges)
+	}
+
+	return quantities, nil
+}
+
+// sortedItemIds returns the item IDs of quantities in alphabetical order
+func sortedItemIds(quantities map[string]int32) []string {
+	itemIds := make([]string, 0, len(quantities))
+	for itemId := range quantities {
+		itemIds = append(itemIds, itemId)
+	}
+	sort.Strings(itemIds)
+
+	return itemIds
+}
+
+// itemShortfalls returns every item the inventory holds fewer of than quantities
+func itemShortfalls(data *storage.EnergyData, quantities map[string]int32) []*pb.ItemShortfall {
+	var shortfalls []*pb.ItemShortfall
+	for _, itemId := range sortedItemIds(quantities) {
+		required, available := quantities[itemId], data.Inventory[itemId]
+		if available < required {
+			shortfalls = append(shortfalls, &pb.ItemShortfall{
+				ItemId:    itemId,
+				Required:  required,
+				Available: available,
+			})
+		}
+	}
+
+	return shortfalls
+}
+
+// spendItems removes quantities from the inventory.
+// Nothing is removed and every item held in too small a quantity is returned if any is short.
+func spendItems(data *storage.EnergyData, quantities map[string]int32) []*pb.ItemShortfall {
+	if shortfalls := itemShortfalls(data, quantities); len(shortfalls) > 0 {
+		return shortfalls
+	}
+
+	for itemId, qty := range quantities {
+		data.Inventory[itemId] -= qty
+		if data.Inventory[itemId] == 0 {
+			delete(data.Inventory, itemId)
+		}
+	}
+
+	return nil
+}
+
+// shortfallMessage describes the first item the player is short of
+func shortfallMessage(shortfalls []*pb.ItemShortfall) string {
+	return fmt.Sprintf("Insufficient %s. Required: %d, Available: %d",
+		shortfalls[0].ItemId, shortfalls[0].Required, shortfalls[0].Available)
+}
+
+// grantItems adds quantities to the inventory.
+// Nothing is added if any item would exceed its max stack or the items would not fit in the free slots.
+func grantItems(config *economy.Config, data *storage.EnergyData, quantities map[string]int32) error {
+	if err := checkItemRoom(config, data.Inventory, quantities); err != nil {
+		return err
+	}
+
+	if data.Inventory == nil {
+		data.Inventory = make(map[string]int32)
+	}
+	for itemId, qty := range quantities {
+		data.Inventory[itemId] += qty
+	}
+
+	return nil
+}
+
+// checkItemRoom checks quantities fit in inventory, within every item's max stack and the free slots
+func checkItemRoom(config *economy.Config, inventory map[string]int32, quantities map[string]int32) error {
+	var newSlots int32
+	for _, itemId := range sortedItemIds(quantities) {
+		held := inventory[itemId]
+		if quantities[itemId] > config.StackLimit(itemId)-held {
+			return status.Errorf(codes.FailedPrecondition,
+				"Granting %d %s would exceed its max stack of %d", quantities[itemId], itemId, config.StackLimit(itemId))
+		}
+		if held == 0 {
+			newSlots++
+		}
+	}
+	if maxSlots := config.Inventory.MaxSlots; maxSlots > 0 && int32(len(inventory))+newSlots > maxSlots {
+		return status.Errorf(codes.FailedPrecondition, "Granting %d new items would exceed the %d inventory slots",
+			newSlots, maxSlots)
+	}
+
+	return nil
+}
+
+// itemRoom returns how much more of an item fits in the inventory, within its max stack and the free slots
+func itemRoom(config *economy.Config, data *storage.EnergyData, itemId string) int32 {
+	held := data.Inventory[itemId]
+	if maxSlots := config.Inventory.MaxSlots; held == 0 && maxSlots > 0 && int32(len(data.Inventory)) >= maxSlots {
+		return 0
+	}
+
+	// A lowered max stack keeps what the player already holds
+	return max(config.StackLimit(itemId)-held, 0)
+}
+
+// addItem adds as much of quantity as fits to the inventory, returning the quantity added
+func addItem(config *economy.Config, data *storage.EnergyData, itemId string, quantity int32) int32 {
+	added := min(quantity, itemRoom(config, data, itemId))
+	if added > 0 {
+		if data.Inventory == nil {
+			data.Inventory = make(map[string]int32)
+		}
+		data.Inventory[itemId] += added
+	}
+
+	return added
+}
+
+// What happened to loot that did not fit in the inventory, see pb.LootItem.Overflow
+const (
+	lootDiscarded = "discarded"
+	lootConverted = "converted"
+	lootPending   = "pending"
+)
+
+// grantLoot adds loot to the player's inventory, marking how much of each item was granted.
+// What does not fit is discarded, converted or kept pending as the inventory limits say,
+// the convert item paid for converted loot is returned as extra loot after the rolled loot.
+func grantLoot(config *economy.Config, data *storage.EnergyData, loot []*pb.LootItem) []*pb.LootItem {
+	mode := config.Inventory.LootOverflowMode()
+	convertItemId := config.Inventory.ConvertItem()
+
+	var converted int64
+	for _, item := range loot {
+		item.Granted = addItem(config, data, item.ItemId, item.Quantity)
+		rest := item.Quantity - item.Granted
+		if rest == 0 {
+			continue
+		}
+
+		catalogItem, _ := config.Item(item.ItemId)
+		switch {
+		case mode == economy.LootOverflowPending:
+			addPendingItem(data, item.ItemId, rest)
+			item.Overflow = lootPending
+		case mode == economy.LootOverflowConvert && catalogItem.ConvertValue > 0 && item.ItemId != convertItemId:
+			converted += int64(rest) * int64(catalogItem.ConvertValue)
+			item.Overflow = lootConverted
+		default:
+			item.Overflow = lootDiscarded
+		}
+	}
+
+	if converted > 0 {
+		payment := &pb.LootItem{
+			ItemId:   convertItemId,
+			ItemName: config.ItemName(convertItemId),
+			Quantity: int32(min(converted, math.MaxInt32)),
+		}
+		payment.Granted = addItem(config, data, convertItemId, payment.Quantity)
+		if payment.Granted < payment.Quantity {
+			payment.Overflow = lootDiscarded
+		}
+		loot = append(loot, payment)
+	}
+
+	return loot
+}
+
+// addPendingItem keeps loot that did not fit for the player to claim later
+func addPendingItem(data *storage.EnergyData, itemId string, quantity int32) {
+	if data.PendingItems == nil {
+		data.PendingItems = make(map[string]int32)
+	}
+	data.PendingItems[itemId] += min(quantity, math.MaxInt32-data.PendingItems[itemId])
+}
+
+// claimPendingItems moves as much of every pending item as fits into the inventory, returning the quantities moved
+func claimPendingItems(config *economy.Config, data *storage.EnergyData) map[string]int32 {
+	claimed := make(map[string]int32)
+	for _, itemId := range sortedItemIds(data.PendingItems) {
+		added := addItem(config, data, itemId, data.PendingItems[itemId])
+		if added == 0 {
+			continue
+		}
+
+		claimed[itemId] = added
+		data.PendingItems[itemId] -= added
+		if data.PendingItems[itemId] == 0 {
+			delete(data.PendingItems, itemId)
+		}
+	}
+	if len(data.PendingItems) == 0 {
+		data.PendingItems = nil
+	}
+
+	return claimed
+}
+
+// toInventoryItems converts the inventory to its API representation in item ID order
+func toInventoryItems(config *economy.Config, inventory map[string]int32, language string) []*pb.InventoryItem {
+	var items []*pb.InventoryItem
+	for _, itemId := range sortedItemIds(inventory) {
+		items = append(items, toInventoryItem(config, itemId, inventory[itemId], language))
+	}
+
+	return items
+}
+
+// formatItemQuantities describes item quantities, e.g. "100 gold, 2 herb"
+func formatItemQuantities(quantities map[string]int32) string {
+	parts := make([]string, 0, len(quantities))
+	for _, itemId := range sortedItemIds(quantities) {
+		parts = append(parts, fmt.Sprintf("%d %s", quantities[itemId], itemId))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// removeItems spends or revokes items from a player's inventory, recording the change as entry.
+// Nothing is removed and the shortfalls are returned if the player holds too few of any item.
+func (s *EnergyServiceServerImpl) removeItems(
+	ctx context.Context, namespace string, userId string, items []*pb.ItemQuantity, entry *storage.LedgerEntry,
+) (*pb.UpdateInventoryResponse, error) {
+	economyConfig := s.economy.Current()
+
+	quantities, err := itemQuantities(economyConfig, items, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var currentData *storage.EnergyData
+	var shortfalls []*pb.ItemShortfall
+
+	updatedData, err := s.updateEnergyData(ctx, namespace, userId, entry,
+		func(data *storage.EnergyData, _ *pb.EnergyState, _ int64) (bool, error) {
+			currentData = data
+			shortfalls = spendItems(data, quantities)
+
+			return len(shortfalls) == 0, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	// Too few of some item, nothing was saved
+	if updatedData == nil {
+		return &pb.UpdateInventoryResponse{
+			Items:      toInventoryItems(economyConfig, currentData.Inventory, ""),
+			Success:    false,
+			Message:    shortfallMessage(shortfalls),
+			Shortfalls: shortfalls,
+		}, nil
+	}
+
+	return &pb.UpdateInventoryResponse{
+		Items:   toInventoryItems(economyConfig, updatedData.Inventory, ""),
+		Success: true,
+		Message: fmt.Sprintf("Removed %s", formatItemQuantities(quantities)),
+	}, nil
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"extend-custom-guild-service/pkg/economy"
+	pb "extend-custom-guild-service/pkg/pb"
+	"extend-custom-guild-service/pkg/storage"
+)
+
+// lootResult is what became of one loot item
+type lootResult struct {
+	itemId   string
+	granted  int32
+	overflow string
+}
+
+// The player holds 8 of the 10 map pieces that stack and fills both slots, so 3 of the
+// dropped map pieces and both herbs do not fit. A map piece converts to 10 gold, a herb to 1.
+func TestGrantLootOverflow(t *testing.T) {
+	tests := []struct {
+		name          string
+		mode          string
+		wantLoot      []lootResult
+		wantInventory map[string]int32
+		wantPending   map[string]int32
+	}{
+		{
+			name: "discard",
+			mode: economy.LootOverflowDiscard,
+			wantLoot: []lootResult{
+				{itemId: "map_piece", granted: 2, overflow: lootDiscarded},
+				{itemId: "herb", overflow: lootDiscarded},
+			},
+			wantInventory: map[string]int32{"map_piece": 10, "gold": 5},
+		},
+		{
+			name: "convert",
+			mode: economy.LootOverflowConvert,
+			wantLoot: []lootResult{
+				{itemId: "map_piece", granted: 2, overflow: lootConverted},
+				{itemId: "herb", overflow: lootConverted},
+				{itemId: "gold", granted: 32},
+			},
+			wantInventory: map[string]int32{"map_piece": 10, "gold": 37},
+		},
+		{
+			name: "pending",
+			mode: economy.LootOverflowPending,
+			wantLoot: []lootResult{
+				{itemId: "map_piece", granted: 2, overflow: lootPending},
+				{itemId: "herb", overflow: lootPending},
+			},
+			wantInventory: map[string]int32{"map_piece": 10, "gold": 5},
+			wantPending:   map[string]int32{"map_piece": 3, "herb": 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := loadTestConfig(t)
+			config.Inventory.MaxSlots = 2
+			config.Inventory.LootOverflow = tt.mode
+			data := &storage.EnergyData{Inventory: map[string]int32{"map_piece": 8, "gold": 5}}
+
+			loot := grantLoot(config, data, []*pb.LootItem{
+				{ItemId: "map_piece", Quantity: 5},
+				{ItemId: "herb", Quantity: 2},
+			})
+
+			if len(loot) != len(tt.wantLoot) {
+				t.Fatalf("loot = %v, want %d items", loot, len(tt.wantLoot))
+			}
+			for i, item := range loot {
+				got := lootResult{itemId: item.ItemId, granted: item.Granted, overflow: item.Overflow}
+				if got != tt.wantLoot[i] {
+					t.Errorf("loot[%d] = %+v, want %+v", i, got, tt.wantLoot[i])
+				}
+			}
+			if !reflect.DeepEqual(data.Inventory, tt.wantInventory) {
+				t.Errorf("Inventory = %v, want %v", data.Inventory, tt.wantInventory)
+			}
+			if !reflect.DeepEqual(data.PendingItems, tt.wantPending) {
+				t.Errorf("PendingItems = %v, want %v", data.PendingItems, tt.wantPending)
+			}
+		})
+	}
+}
+
+// Claiming moves what fits and keeps the rest pending
+func TestClaimPendingItems(t *testing.T) {
+	config := loadTestConfig(t)
+	config.Inventory.MaxSlots = 2
+	data := &storage.EnergyData{
+		Inventory:    map[string]int32{"map_piece": 5, "gold": 5},
+		PendingItems: map[string]int32{"map_piece": 8, "herb": 2},
+	}
+
+	claimed := claimPendingItems(config, data)
+
+	if want := map[string]int32{"map_piece": 5}; !reflect.DeepEqual(claimed, want) {
+		t.Errorf("claimed = %v, want %v", claimed, want)
+	}
+	if want := map[string]int32{"map_piece": 10, "gold": 5}; !reflect.DeepEqual(data.Inventory, want) {
+		t.Errorf("Inventory = %v, want %v", data.Inventory, want)
+	}
+	if want := map[string]int32{"map_piece": 3, "herb": 2}; !reflect.DeepEqual(data.PendingItems, want) {
+		t.Errorf("PendingItems = %v, want %v", data.PendingItems, want)
+	}
+}
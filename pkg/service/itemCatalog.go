@@ -0,0 +1,43 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"extend-custom-guild-service/pkg/economy"
+	pb "extend-custom-guild-service/pkg/pb"
+)
+
+// toInventoryItem converts an inventory stack to its API representation with the catalog's item details.
+// An item no longer in the catalog is shown by its ID.
+func toInventoryItem(config *economy.Config, itemId string, quantity int32, language string) *pb.InventoryItem {
+	inventoryItem := &pb.InventoryItem{
+		ItemId:   itemId,
+		ItemName: config.LocalizedItemName(itemId, language),
+		Quantity: quantity,
+	}
+
+	if item, ok := config.Item(itemId); ok {
+		inventoryItem.Rarity = item.RarityOrDefault()
+		inventoryItem.Category = item.Category
+		inventoryItem.IconKey = item.IconKey
+	}
+
+	return inventoryItem
+}
+
+// toCatalogItem converts a catalog item to its API representation, named in language
+func toCatalogItem(itemId string, item economy.Item, language string) *pb.CatalogItem {
+	return &pb.CatalogItem{
+		ItemId:         itemId,
+		Name:           item.LocalizedName(language),
+		LocalizedNames: item.LocalizedNames,
+		Rarity:         item.RarityOrDefault(),
+		Category:       item.Category,
+		IconKey:        item.IconKey,
+		MaxStack:       item.MaxStack,
+		Tradable:       item.Tradable,
+		Sellable:       item.Sellable,
+	}
+}
@@ -0,0 +1,307 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"context"
+	"extend-custom-guild-service/pkg/economy"
+	pb "extend-custom-guild-service/pkg/pb"
+	"extend-custom-guild-service/pkg/storage"
+	"log/slog"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Who made a change recorded in the ledger
+const (
+	ledgerActorPlayer = "player"
+	ledgerActorAdmin  = "admin"
+	ledgerActorSystem = "system" // Expired holds refunded by the service
+)
+
+// Page sizes of the ledger history
+const (
+	defaultLedgerPageSize = 20
+	maxLedgerPageSize     = 100
+)
+
+// maxPendingLedgerEntries bounds the entries kept on a player's record while the ledger
+// cannot be written, the oldest are dropped beyond it
+const maxPendingLedgerEntries = 100
+
+// maxFlushedLedgerPlayers bounds how many players flushedLedgerEntries remembers. Past it the
+// memory is cleared, and the next change of a player asks the ledger again, which skips the
+// entries it already has.
+const maxFlushedLedgerPlayers = 10000
+
+// flushedLedgerEntries remembers the last entry of each player the ledger accepted. Entries are
+// added to the ledger after the change queuing them was saved, so they stay on the saved record
+// until the next change, which drops them without asking the ledger again.
+type flushedLedgerEntries struct {
+	mu      sync.Mutex
+	entries map[string]string // namespace/userId -> EntryId
+}
+
+func newFlushedLedgerEntries() *flushedLedgerEntries {
+	return &flushedLedgerEntries{entries: make(map[string]string)}
+}
+
+// unflushed returns the entries after the last one of the player the ledger accepted
+func (f *flushedLedgerEntries) unflushed(namespace string, userId string, entries []storage.LedgerEntry) []storage.LedgerEntry {
+	f.mu.Lock()
+	last, ok := f.entries[namespace+"/"+userId]
+	f.mu.Unlock()
+
+	if ok {
+		for i, entry := range entries {
+			if entry.EntryId == last {
+				return entries[i+1:]
+			}
+		}
+	}
+
+	return entries
+}
+
+// remember records that the ledger accepted the player's entries up to entryId
+func (f *flushedLedgerEntries) remember(namespace string, userId string, entryId string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if len(f.entries) >= maxFlushedLedgerPlayers {
+		clear(f.entries)
+	}
+	f.entries[namespace+"/"+userId] = entryId
+}
+
+// ledgerSnapshot is the energy of every pool and the inventory of a player at one point in time
+type ledgerSnapshot struct {
+	pools map[string]int32
+	items map[string]int32
+}
+
+// takeLedgerSnapshot records the player's regenerated energy and inventory at now
+func takeLedgerSnapshot(config *economy.Config, data *storage.EnergyData, now int64) ledgerSnapshot {
+	snapshot := ledgerSnapshot{
+		pools: make(map[string]int32),
+		items: make(map[string]int32, len(data.Inventory)),
+	}
+	for _, poolId := range config.PoolIDs() {
+		snapshot.pools[poolId] = regeneratePool(config, data, poolId, now).energy
+	}
+	for itemId, qty := range data.Inventory {
+		snapshot.items[itemId] = qty
+	}
+
+	return snapshot
+}
+
+// setLedgerChanges fills entry with the changes between two snapshots,
+// reporting whether anything changed
+func setLedgerChanges(config *economy.Config, entry *storage.LedgerEntry, before ledgerSnapshot, after ledgerSnapshot) bool {
+	entry.Pools = nil
+	for _, poolId := range config.PoolIDs() {
+		if delta := after.pools[poolId] - before.pools[poolId]; delta != 0 {
+			entry.Pools = append(entry.Pools, storage.LedgerPoolChange{
+				PoolId:        poolId,
+				Delta:         delta,
+				BalanceBefore: before.pools[poolId],
+				BalanceAfter:  after.pools[poolId],
+			})
+		}
+	}
+
+	entry.Items = nil
+	for itemId := range mergeItemIds(before.items, after.items) {
+		if delta := after.items[itemId] - before.items[itemId]; delta != 0 {
+			if entry.Items == nil {
+				entry.Items = make(map[string]int32)
+			}
+			entry.Items[itemId] = delta
+		}
+	}
+
+	return len(entry.Pools) > 0 || len(entry.Items) > 0
+}
+
+// mergeItemIds returns the item IDs of both inventories
+func mergeItemIds(a map[string]int32, b map[string]int32) map[string]bool {
+	itemIds := make(map[string]bool, len(a)+len(b))
+	for itemId := range a {
+		itemIds[itemId] = true
+	}
+	for itemId := range b {
+		itemIds[itemId] = true
+	}
+
+	return itemIds
+}
+
+// newLedgerEntry creates an entry for a change made now by the caller of the request in ctx
+func newLedgerEntry(ctx context.Context, entry *storage.LedgerEntry, now int64) storage.LedgerEntry {
+	recorded := *entry
+	recorded.EntryId = uuid.NewString()
+	recorded.Timestamp = now
+	if method, ok := grpc.Method(ctx); ok {
+		recorded.Operation = path.Base(method)
+	}
+	if claims, err := parseTokenClaims(ctx); err == nil {
+		recorded.ActorId = claims.Sub
+		if recorded.ActorId == "" {
+			recorded.ActorId = claims.ClientId
+		}
+	}
+
+	return recorded
+}
+
+// expiredHoldsEntry creates the entry of the service refunding expired holds
+func expiredHoldsEntry(data *storage.EnergyData, now int64) storage.LedgerEntry {
+	var holdIds []string
+	for _, hold := range data.Holds {
+		if hold.ExpiresAt*1000 <= now {
+			holdIds = append(holdIds, hold.HoldId)
+		}
+	}
+
+	return storage.LedgerEntry{
+		EntryId:   uuid.NewString(),
+		Timestamp: now,
+		Operation: "ReleaseExpiredHolds",
+		Actor:     ledgerActorSystem,
+		ActionId:  strings.Join(holdIds, ","),
+	}
+}
+
+// queueLedgerEntries adds the entries of a change to the pending entries saved with it
+func (s *EnergyServiceServerImpl) queueLedgerEntries(data *storage.EnergyData, entries []storage.LedgerEntry) {
+	if s.ledger == nil || len(entries) == 0 {
+		return
+	}
+
+	pending := append(data.PendingLedgerEntries, entries...)
+	if dropped := len(pending) - maxPendingLedgerEntries; dropped > 0 {
+		slog.Error("dropping energy ledger entries the ledger did not accept",
+			"userId", data.UserId, "entries", dropped)
+		pending = pending[dropped:]
+	}
+	data.PendingLedgerEntries = pending
+}
+
+// flushLedgerEntries adds pending entries to the ledger and reports whether the ledger has them.
+// A failure is logged rather than returned to the client: the entries stay on the player's
+// record and the next change adds them, the ledger skipping those it already has.
+func (s *EnergyServiceServerImpl) flushLedgerEntries(
+	ctx context.Context, namespace string, userId string, entries []storage.LedgerEntry,
+) bool {
+	if s.ledger == nil || len(entries) == 0 {
+		return true
+	}
+
+	if err := s.ledger.AppendLedgerEntries(ctx, namespace, userId, entries); err != nil {
+		slog.Warn("unable to append energy ledger entries, retrying with the next change",
+			"namespace", namespace, "userId", userId, "entries", len(entries), "error", err)
+		return false
+	}
+	s.flushedLedger.remember(namespace, userId, entries[len(entries)-1].EntryId)
+
+	return true
+}
+
+// listLedgerEntries returns a page of the player's ledger, start and end time being Unix timestamps
+func (s *EnergyServiceServerImpl) listLedgerEntries(
+	ctx context.Context, namespace string, userId string, startTime int64, endTime int64, pageSize int32, cursor string,
+) (*pb.ListEnergyHistoryResponse, error) {
+	if s.ledger == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Energy ledger is not configured")
+	}
+	if startTime < 0 || endTime < 0 || (endTime > 0 && endTime <= startTime) {
+		return nil, status.Errorf(codes.InvalidArgument, "End time must be after start time")
+	}
+	if pageSize < 0 || pageSize > maxLedgerPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "Page size must be between 1 and %d", maxLedgerPageSize)
+	}
+	if pageSize == 0 {
+		pageSize = defaultLedgerPageSize
+	}
+
+	query := storage.LedgerQuery{
+		StartTime: startTime * 1000,
+		EndTime:   endTime * 1000,
+		Limit:     int(pageSize),
+	}
+	if cursor != "" {
+		sequence, err := strconv.ParseInt(cursor, 10, 64)
+		if err != nil || sequence <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid cursor: %s", cursor)
+		}
+		query.BeforeSequence = sequence
+	}
+
+	entries, more, err := s.ledger.ListLedgerEntries(ctx, namespace, userId, query)
+	if err != nil {
+		return nil, status.Errorf(status.Code(err), "Failed to list energy ledger: %v", err)
+	}
+
+	economyConfig := s.economy.Current()
+	response := &pb.ListEnergyHistoryResponse{}
+	for _, entry := range entries {
+		response.Entries = append(response.Entries, toLedgerEntry(economyConfig, entry))
+	}
+	if more && len(entries) > 0 {
+		response.NextCursor = strconv.FormatInt(entries[len(entries)-1].Sequence, 10)
+	}
+
+	return response, nil
+}
+
+// toLedgerEntry converts a stored ledger entry to its API representation
+func toLedgerEntry(config *economy.Config, entry storage.LedgerEntry) *pb.LedgerEntry {
+	ledgerEntry := &pb.LedgerEntry{
+		EntryId:       entry.EntryId,
+		Timestamp:     entry.Timestamp / 1000,
+		TimestampMs:   entry.Timestamp,
+		Operation:     entry.Operation,
+		Actor:         entry.Actor,
+		ActorId:       entry.ActorId,
+		ActionType:    entry.ActionType,
+		ActionId:      entry.ActionId,
+		Source:        entry.Source,
+		TransactionId: entry.TransactionId,
+		Reason:        entry.Reason,
+	}
+
+	for _, pool := range entry.Pools {
+		ledgerEntry.Pools = append(ledgerEntry.Pools, &pb.LedgerPoolChange{
+			PoolId:        pool.PoolId,
+			Delta:         pool.Delta,
+			BalanceBefore: pool.BalanceBefore,
+			BalanceAfter:  pool.BalanceAfter,
+		})
+	}
+
+	itemIds := make([]string, 0, len(entry.Items))
+	for itemId := range entry.Items {
+		itemIds = append(itemIds, itemId)
+	}
+	sort.Strings(itemIds)
+	for _, itemId := range itemIds {
+		ledgerEntry.Items = append(ledgerEntry.Items, &pb.LootItem{
+			ItemId:   itemId,
+			ItemName: config.ItemName(itemId),
+			Quantity: entry.Items[itemId],
+		})
+	}
+
+	return ledgerEntry
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "extend-custom-guild-service/pkg/pb"
+	"extend-custom-guild-service/pkg/storage"
+)
+
+// countingLedgerStore records how many entries every append carried
+type countingLedgerStore struct {
+	*storage.MemoryLedgerStore
+	appends []int
+}
+
+func (m *countingLedgerStore) AppendLedgerEntries(
+	ctx context.Context, namespace string, userId string, entries []storage.LedgerEntry,
+) error {
+	m.appends = append(m.appends, len(entries))
+
+	return m.MemoryLedgerStore.AppendLedgerEntries(ctx, namespace, userId, entries)
+}
+
+func TestLedgerAppendsOnlyNewEntries(t *testing.T) {
+	ledger := &countingLedgerStore{MemoryLedgerStore: storage.NewMemoryLedgerStore()}
+	s, _, _ := newTestServer(t, WithLedgerStore(ledger))
+
+	// The second fight does not send the entry of the first again
+	fight()(t, s, nil)
+	fight()(t, s, nil)
+	if len(ledger.appends) != 2 || ledger.appends[0] != 1 || ledger.appends[1] != 1 {
+		t.Errorf("appended entries = %v, want [1 1]", ledger.appends)
+	}
+
+	// A saved change that moves neither energy nor items is not recorded
+	ledger.appends = nil
+	if _, err := s.updateEnergyData(context.Background(), testNamespace, testUserId,
+		&storage.LedgerEntry{Actor: ledgerActorAdmin},
+		func(_ *storage.EnergyData, _ *pb.EnergyState, _ int64) (bool, error) {
+			return true, nil
+		}); err != nil {
+		t.Fatalf("updateEnergyData: %v", err)
+	}
+	if len(ledger.appends) > 0 {
+		t.Errorf("appended entries = %v, want none", ledger.appends)
+	}
+
+	entries, _, err := ledger.ListLedgerEntries(context.Background(), testNamespace, testUserId, storage.LedgerQuery{Limit: 10})
+	if err != nil {
+		t.Fatalf("list ledger entries: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Errorf("ledger has %d entries, want 2", len(entries))
+	}
+}
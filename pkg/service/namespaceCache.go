@@ -0,0 +1,64 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// namespaceCacheTTL is how long namespace-wide settings are cached. A change made
+// through another instance takes effect here within this time, so instances disagree
+// for up to this long after CreateRegenBoost or CancelRegenBoost: a player served by
+// another instance meanwhile regenerates without the new event, or with the cancelled
+// one, and that progress is saved. Namespace events starting at least this far in the
+// future apply the same on every instance.
+const namespaceCacheTTL = 15 * time.Second
+
+// namespaceCache caches a namespace-wide setting that every regen calculation needs,
+// e.g. the namespace regen events
+type namespaceCache[T any] struct {
+	load func(ctx context.Context, namespace string) (T, error)
+
+	mu      sync.Mutex
+	entries map[string]namespaceCacheEntry[T]
+}
+
+type namespaceCacheEntry[T any] struct {
+	value     T
+	expiresAt time.Time
+}
+
+func newNamespaceCache[T any](load func(ctx context.Context, namespace string) (T, error)) *namespaceCache[T] {
+	return &namespaceCache[T]{load: load, entries: make(map[string]namespaceCacheEntry[T])}
+}
+
+// get returns the cached value of a namespace, loading it when missing or expired
+func (c *namespaceCache[T]) get(ctx context.Context, namespace string) (T, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[namespace]
+	c.mu.Unlock()
+
+	if ok && time.Now().Before(entry.expiresAt) {
+		return entry.value, nil
+	}
+
+	value, err := c.load(ctx, namespace)
+	if err != nil {
+		return value, err
+	}
+	c.set(namespace, value)
+
+	return value, nil
+}
+
+// set replaces the cached value of a namespace
+func (c *namespaceCache[T]) set(namespace string, value T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[namespace] = namespaceCacheEntry[T]{value: value, expiresAt: time.Now().Add(namespaceCacheTTL)}
+}
@@ -0,0 +1,245 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"extend-custom-guild-service/pkg/economy"
+	pb "extend-custom-guild-service/pkg/pb"
+	"extend-custom-guild-service/pkg/purchase"
+	"extend-custom-guild-service/pkg/storage"
+)
+
+// flakyVerifier counts the purchases it verifies and fails to consume them while failConsume is set
+type flakyVerifier struct {
+	*purchase.FakeVerifier
+	failConsume bool
+	verified    int
+}
+
+func (v *flakyVerifier) VerifyPurchase(
+	ctx context.Context, namespace string, userId string, transactionId string, itemId string,
+) error {
+	v.verified++
+
+	return v.FakeVerifier.VerifyPurchase(ctx, namespace, userId, transactionId, itemId)
+}
+
+func (v *flakyVerifier) ConsumePurchase(ctx context.Context, namespace string, userId string, transactionId string) error {
+	if v.failConsume {
+		return status.Errorf(codes.Unavailable, "Platform is unavailable")
+	}
+
+	return v.FakeVerifier.ConsumePurchase(ctx, namespace, userId, transactionId)
+}
+
+// purchaseRefill refills from the purchase source with the energy_pack bought in transactionId
+func purchaseRefill(s *EnergyServiceServerImpl, transactionId string) (*pb.RefillEnergyResponse, error) {
+	return s.RefillMyEnergy(context.Background(), &pb.RefillMyEnergyRequest{
+		Namespace:     testNamespace,
+		UserId:        testUserId,
+		Source:        "purchase",
+		ItemId:        "energy_pack",
+		TransactionId: transactionId,
+	})
+}
+
+// assertPools checks the energy of every pool energy_pack refills
+func assertPools(t *testing.T, s *EnergyServiceServerImpl, energy int32, tickets int32, keys int32) {
+	t.Helper()
+
+	resp, err := s.GetMyEnergy(context.Background(), &pb.GetMyEnergyRequest{Namespace: testNamespace, UserId: testUserId})
+	if err != nil {
+		t.Fatalf("get energy: %v", err)
+	}
+
+	got := make(map[string]int32, len(resp.Pools))
+	for _, pool := range resp.Pools {
+		got[pool.PoolId] = pool.CurrentEnergy
+	}
+	if got["energy"] != energy || got["tickets"] != tickets || got["keys"] != keys {
+		t.Errorf("energy, tickets, keys = %d, %d, %d, want %d, %d, %d",
+			got["energy"], got["tickets"], got["keys"], energy, tickets, keys)
+	}
+}
+
+func TestPurchaseRefillRejected(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(verifier *purchase.FakeVerifier)
+		wantCode codes.Code
+	}{
+		{
+			name: "purchase of another player",
+			setup: func(verifier *purchase.FakeVerifier) {
+				verifier.AddPurchase(testNamespace, "other", "txn", "energy_pack")
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "purchase of another item",
+			setup: func(verifier *purchase.FakeVerifier) {
+				verifier.AddPurchase(testNamespace, testUserId, "txn", "energy_pack_small")
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "consumed purchase",
+			setup: func(verifier *purchase.FakeVerifier) {
+				verifier.AddPurchase(testNamespace, testUserId, "txn", "energy_pack")
+				_ = verifier.ConsumePurchase(context.Background(), testNamespace, testUserId, "txn")
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verifier := purchase.NewFakeVerifier()
+			tt.setup(verifier)
+			s, _, _ := newTestServer(t, WithPurchaseVerifier(verifier))
+
+			_, err := purchaseRefill(s, "txn")
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("RefillMyEnergy error = %v, want %s", err, tt.wantCode)
+			}
+
+			// Nothing is refilled
+			assertPools(t, s, 100, 5, 3)
+		})
+	}
+}
+
+func TestPurchaseRefillCreditsEveryPoolOnce(t *testing.T) {
+	verifier := purchase.NewFakeVerifier()
+	verifier.AddPurchase(testNamespace, testUserId, "txn", "energy_pack")
+	s, _, clock := newTestServer(t, WithPurchaseVerifier(verifier))
+
+	first, err := purchaseRefill(s, "txn")
+	if err != nil || !first.Success {
+		t.Fatalf("RefillMyEnergy = %v, %v", first, err)
+	}
+	if len(first.Pools) != 3 {
+		t.Errorf("len(Pools) = %d, want 3", len(first.Pools))
+	}
+	if !verifier.Consumed(testNamespace, "txn") {
+		t.Error("purchase was not consumed")
+	}
+	assertPools(t, s, 200, 10, 6)
+
+	// A retry replays the original response without refilling again
+	clock.Advance(time.Minute)
+	replayed, err := purchaseRefill(s, "txn")
+	if err != nil {
+		t.Fatalf("replayed RefillMyEnergy: %v", err)
+	}
+	if replayed.Message != first.Message || replayed.EnergyState.CurrentEnergy != first.EnergyState.CurrentEnergy {
+		t.Errorf("replayed response = %v, want %v", replayed, first)
+	}
+	assertPools(t, s, 200, 10, 6)
+}
+
+func TestPurchaseRefillConsumeFailure(t *testing.T) {
+	verifier := &flakyVerifier{FakeVerifier: purchase.NewFakeVerifier(), failConsume: true}
+	verifier.AddPurchase(testNamespace, testUserId, "txn", "energy_pack")
+	s, store, _ := newTestServer(t, WithPurchaseVerifier(verifier))
+
+	// The refill is saved and succeeds, the purchase stays to be consumed
+	first, err := purchaseRefill(s, "txn")
+	if err != nil || !first.Success {
+		t.Fatalf("RefillMyEnergy = %v, %v", first, err)
+	}
+	if verifier.Consumed(testNamespace, "txn") {
+		t.Fatal("purchase was consumed")
+	}
+	assertPools(t, s, 200, 10, 6)
+
+	data, err := store.GetEnergyData(context.Background(), testNamespace, testUserId)
+	if err != nil {
+		t.Fatalf("get energy data: %v", err)
+	}
+	if !refillTransactionPending(data, "txn") {
+		t.Fatal("transaction is not pending consume")
+	}
+
+	// Sending it again consumes the purchase without refilling again or verifying the purchase
+	verifier.failConsume = false
+	resp, err := purchaseRefill(s, "txn")
+	if err != nil || resp.Message != first.Message {
+		t.Fatalf("retried RefillMyEnergy = %v, %v, want %v", resp, err, first)
+	}
+	if verifier.verified != 1 {
+		t.Errorf("purchase verified %d times, want 1", verifier.verified)
+	}
+	if !verifier.Consumed(testNamespace, "txn") {
+		t.Error("purchase was not consumed")
+	}
+	assertPools(t, s, 200, 10, 6)
+
+	data, err = store.GetEnergyData(context.Background(), testNamespace, testUserId)
+	if err != nil {
+		t.Fatalf("get energy data: %v", err)
+	}
+	if refillTransactionPending(data, "txn") {
+		t.Error("transaction is still pending consume")
+	}
+}
+
+func TestPurchaseRefillVerifiesOnceOnConflict(t *testing.T) {
+	verifier := &flakyVerifier{FakeVerifier: purchase.NewFakeVerifier()}
+	verifier.AddPurchase(testNamespace, testUserId, "txn", "energy_pack")
+	store := &interleavedStorage{MemoryStorage: storage.NewMemoryStorage()}
+	s := NewEnergyServiceServer(nil, nil, nil, store, economy.NewStaticProvider(loadTestConfig(t)),
+		WithClock(NewFakeClock(testStart)), WithPurchaseVerifier(verifier))
+
+	// Create the player, then conflict with the first save of the refill
+	assertPools(t, s, 100, 5, 3)
+	store.writes = 1
+
+	resp, err := purchaseRefill(s, "txn")
+	if err != nil || !resp.Success {
+		t.Fatalf("RefillMyEnergy = %v, %v", resp, err)
+	}
+	if verifier.verified != 1 {
+		t.Errorf("purchase verified %d times, want 1", verifier.verified)
+	}
+	assertPools(t, s, 200, 10, 6)
+}
+
+func TestRecordRefillTransactionKeepsPendingConsume(t *testing.T) {
+	now := testStart.UnixMilli()
+	data := &storage.EnergyData{
+		RefillTransactions: []storage.RefillTransaction{
+			{TransactionId: "pending", PendingConsume: true},
+			{TransactionId: "expired"},
+		},
+	}
+	for i := range maxRefillTransactions {
+		err := recordRefillTransaction(data, fmt.Sprintf("txn-%d", i), "daily", "energy", "",
+			&pb.RefillEnergyResponse{}, false, now)
+		if err != nil {
+			t.Fatalf("record refill transaction: %v", err)
+		}
+	}
+
+	if len(data.RefillTransactions) != maxRefillTransactions {
+		t.Errorf("len(RefillTransactions) = %d, want %d", len(data.RefillTransactions), maxRefillTransactions)
+	}
+	if !refillTransactionPending(data, "pending") {
+		t.Error("pending transaction was dropped")
+	}
+	for _, transaction := range data.RefillTransactions {
+		if transaction.TransactionId == "expired" || transaction.TransactionId == "txn-0" {
+			t.Errorf("transaction %s was kept", transaction.TransactionId)
+		}
+	}
+}
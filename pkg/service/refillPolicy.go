@@ -0,0 +1,66 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"extend-custom-guild-service/pkg/economy"
+	pb "extend-custom-guild-service/pkg/pb"
+	"extend-custom-guild-service/pkg/storage"
+	"time"
+)
+
+// refillUsage returns the player's usage of a source, with the daily uses reset
+// when the policy day changed since the last refill
+func refillUsage(policy economy.RefillPolicy, data *storage.EnergyData, source string, now time.Time) storage.RefillUsage {
+	usage := data.RefillUsage[source]
+	if dayStart := policy.DayStart(now).Unix(); usage.DayStart != dayStart {
+		usage.DayStart = dayStart
+		usage.UsesToday = 0
+	}
+
+	return usage
+}
+
+// nextRefillTime returns the Unix timestamp the player can next refill from a source,
+// 0 if it is available now
+func nextRefillTime(policy economy.RefillPolicy, usage storage.RefillUsage, now time.Time) int64 {
+	var next int64
+	if policy.CooldownSeconds > 0 && usage.LastRefillTime > 0 {
+		next = usage.LastRefillTime + policy.CooldownSeconds
+	}
+
+	if policy.MaxPerDay > 0 && usage.UsesToday >= policy.MaxPerDay {
+		next = max(next, policy.DayStart(now).AddDate(0, 0, 1).Unix())
+	}
+
+	if next <= now.Unix() {
+		return 0
+	}
+
+	return next
+}
+
+// recordRefillUse counts a refill from a source against its policy
+func recordRefillUse(policy economy.RefillPolicy, data *storage.EnergyData, source string, now time.Time) {
+	usage := refillUsage(policy, data, source, now)
+	usage.LastRefillTime = now.Unix()
+	usage.UsesToday++
+
+	if data.RefillUsage == nil {
+		data.RefillUsage = make(map[string]storage.RefillUsage)
+	}
+	data.RefillUsage[source] = usage
+}
+
+// setRefillAvailability fills in when the player can next refill from a source
+func setRefillAvailability(
+	response *pb.RefillEnergyResponse, policy economy.RefillPolicy, usage storage.RefillUsage, now time.Time,
+) {
+	response.NextAvailableTime = nextRefillTime(policy, usage, now)
+	response.MaxUsesPerDay = policy.MaxPerDay
+	if policy.MaxPerDay > 0 {
+		response.UsesToday = usage.UsesToday
+	}
+}
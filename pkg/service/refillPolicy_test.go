@@ -0,0 +1,136 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"extend-custom-guild-service/pkg/economy"
+	pb "extend-custom-guild-service/pkg/pb"
+)
+
+// refillAttempt is a daily refill tried some time after the previous one
+type refillAttempt struct {
+	after       time.Duration
+	wantSuccess bool
+	wantNext    int64 // NextAvailableTime after the attempt, 0 = available now
+}
+
+// testStart is 22:13:20 UTC, 07:13:20 the next day in Tokyo
+func TestRefillPolicy(t *testing.T) {
+	start := testStart.Unix()
+
+	tests := []struct {
+		name     string
+		policy   economy.RefillPolicy
+		attempts []refillAttempt
+	}{
+		{
+			name:   "cooldown",
+			policy: economy.RefillPolicy{CooldownSeconds: 300},
+			attempts: []refillAttempt{
+				{wantSuccess: true, wantNext: start + 300},
+				{after: 299 * time.Second, wantNext: start + 300},
+				{after: time.Second, wantSuccess: true, wantNext: start + 600},
+			},
+		},
+		{
+			name:   "max per day resets at the reset hour in UTC",
+			policy: economy.RefillPolicy{MaxPerDay: 1, ResetHour: 23},
+			attempts: []refillAttempt{
+				{wantSuccess: true, wantNext: start + 2800},
+				{after: 2799 * time.Second, wantNext: start + 2800},
+				{after: time.Second, wantSuccess: true, wantNext: start + 2800 + 24*3600},
+			},
+		},
+		{
+			name:   "max per day resets at the reset hour in the time zone",
+			policy: economy.RefillPolicy{MaxPerDay: 2, ResetHour: 4, TimeZone: "Asia/Tokyo"},
+			attempts: []refillAttempt{
+				{wantSuccess: true},
+				{wantSuccess: true, wantNext: start + 74800},
+				{wantNext: start + 74800},
+				{after: 74799 * time.Second, wantNext: start + 74800},
+				{after: time.Second, wantSuccess: true},
+			},
+		},
+		{
+			name:   "cooldown past the daily reset",
+			policy: economy.RefillPolicy{CooldownSeconds: 3600, MaxPerDay: 1, ResetHour: 23},
+			attempts: []refillAttempt{
+				{wantSuccess: true, wantNext: start + 3600},
+				{after: 2800 * time.Second, wantNext: start + 3600},
+				{after: 800 * time.Second, wantSuccess: true, wantNext: start + 2800 + 24*3600},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := loadTestConfig(t)
+			config.RefillPolicies["daily"] = tt.policy
+			s, _, clock := newTestServerWithConfig(t, config)
+
+			for i, attempt := range tt.attempts {
+				clock.Advance(attempt.after)
+
+				resp, err := s.RefillMyEnergy(context.Background(), &pb.RefillMyEnergyRequest{
+					Namespace: testNamespace, UserId: testUserId, Source: "daily",
+				})
+				if err != nil {
+					t.Fatalf("attempt %d: refill: %v", i+1, err)
+				}
+				if resp.Success != attempt.wantSuccess || resp.NextAvailableTime != attempt.wantNext {
+					t.Errorf("attempt %d: Success, NextAvailableTime = %v, %d, want %v, %d (%s)", i+1,
+						resp.Success, resp.NextAvailableTime, attempt.wantSuccess, attempt.wantNext, resp.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestRefillPolicyDisabledNamespaces(t *testing.T) {
+	tests := []struct {
+		name       string
+		namespaces []string
+		wantCode   codes.Code
+	}{
+		{
+			name:       "every namespace",
+			namespaces: []string{"*"},
+			wantCode:   codes.FailedPrecondition,
+		},
+		{
+			name:       "this namespace",
+			namespaces: []string{"prod", testNamespace},
+			wantCode:   codes.FailedPrecondition,
+		},
+		{
+			name:       "other namespaces",
+			namespaces: []string{"prod"},
+			wantCode:   codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := loadTestConfig(t)
+			config.RefillPolicies["debug"] = economy.RefillPolicy{DisabledNamespaces: tt.namespaces}
+			s, _, _ := newTestServerWithConfig(t, config)
+
+			_, err := s.RefillMyEnergy(context.Background(), &pb.RefillMyEnergyRequest{
+				Namespace: testNamespace, UserId: testUserId, Source: "debug",
+			})
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("refill error = %v, want %s", err, tt.wantCode)
+			}
+		})
+	}
+}
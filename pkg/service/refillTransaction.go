@@ -0,0 +1,176 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"context"
+	"errors"
+	pb "extend-custom-guild-service/pkg/pb"
+	"extend-custom-guild-service/pkg/storage"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Processed refill transactions are kept per player for this long, and at most this many
+const (
+	refillTransactionRetention = 7 * 24 * time.Hour
+	maxRefillTransactions      = 100
+)
+
+// findRefillTransaction returns the original response if transactionId was already processed.
+// A transaction ID reused for a different source, pool or item is rejected. poolId is empty
+// for a purchase refill, which refills every pool of the item.
+func findRefillTransaction(
+	data *storage.EnergyData, transactionId string, source string, poolId string, itemId string,
+) (*pb.RefillEnergyResponse, error) {
+	if transactionId == "" {
+		return nil, nil
+	}
+
+	for _, transaction := range data.RefillTransactions {
+		if transaction.TransactionId != transactionId {
+			continue
+		}
+
+		// Transactions recorded before pools existed refilled the default pool
+		samePool := poolId == "" || poolIdOrDefault(transaction.PoolId) == poolId
+		if transaction.Source != source || !samePool || transaction.ItemId != itemId {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"Transaction %s was already used for a different refill", transactionId)
+		}
+
+		var response pb.RefillEnergyResponse
+		if err := protojson.Unmarshal(transaction.Response, &response); err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to read refill transaction %s: %v", transactionId, err)
+		}
+
+		return &response, nil
+	}
+
+	return nil, nil
+}
+
+// refillTransactionPending reports whether the purchase behind a processed refill still has to be consumed
+func refillTransactionPending(data *storage.EnergyData, transactionId string) bool {
+	for _, transaction := range data.RefillTransactions {
+		if transaction.TransactionId == transactionId {
+			return transaction.PendingConsume
+		}
+	}
+
+	return false
+}
+
+// clearPendingConsume marks the purchase behind a processed refill consumed,
+// it reports whether the transaction was still pending
+func clearPendingConsume(data *storage.EnergyData, transactionId string) bool {
+	for i, transaction := range data.RefillTransactions {
+		if transaction.TransactionId == transactionId && transaction.PendingConsume {
+			data.RefillTransactions[i].PendingConsume = false
+			return true
+		}
+	}
+
+	return false
+}
+
+// refillTransactionProcessed reports whether the player's stored record has the refill of transactionId
+func (s *EnergyServiceServerImpl) refillTransactionProcessed(
+	ctx context.Context, namespace string, userId string, transactionId string,
+) (bool, error) {
+	data, err := s.storage.GetEnergyData(ctx, namespace, userId)
+	if errors.Is(err, storage.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, status.Errorf(status.Code(err), "Failed to get energy data: %v", err)
+	}
+
+	for _, transaction := range data.RefillTransactions {
+		if transaction.TransactionId == transactionId {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// consumePurchase consumes the purchase behind a processed refill and clears its pending mark.
+// A failure is logged rather than returned, the refill was saved and the mark keeps the
+// transaction replaying it until a retry consumes the purchase.
+func (s *EnergyServiceServerImpl) consumePurchase(
+	ctx context.Context, namespace string, userId string, transactionId string,
+) {
+	if err := s.purchases.ConsumePurchase(ctx, namespace, userId, transactionId); err != nil {
+		slog.Warn("unable to consume purchase, retrying with the next refill of the transaction",
+			"namespace", namespace, "userId", userId, "transactionId", transactionId, "error", err)
+		return
+	}
+
+	_, err := s.updateEnergyData(ctx, namespace, userId, nil,
+		func(data *storage.EnergyData, _ *pb.EnergyState, _ int64) (bool, error) {
+			return clearPendingConsume(data, transactionId), nil
+		})
+	if err != nil {
+		// The purchase is consumed, so a retry consuming it again is a no-op
+		slog.Warn("unable to clear pending purchase consume",
+			"namespace", namespace, "userId", userId, "transactionId", transactionId, "error", err)
+	}
+}
+
+// recordRefillTransaction stores the response of a processed refill on data and drops
+// transactions older than the retention window. Transactions pending consume are never
+// dropped, their replay is what stops the purchase from refilling again. now is Unix milliseconds.
+func recordRefillTransaction(
+	data *storage.EnergyData, transactionId string, source string, poolId string, itemId string,
+	response *pb.RefillEnergyResponse, pendingConsume bool, now int64,
+) error {
+	if transactionId == "" {
+		return nil
+	}
+
+	responseJSON, err := protojson.Marshal(response)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Failed to record refill transaction %s: %v", transactionId, err)
+	}
+
+	cutoff := (now - refillTransactionRetention.Milliseconds()) / 1000
+
+	transactions := make([]storage.RefillTransaction, 0, len(data.RefillTransactions)+1)
+	for _, transaction := range data.RefillTransactions {
+		if transaction.ProcessedAt >= cutoff || transaction.PendingConsume {
+			transactions = append(transactions, transaction)
+		}
+	}
+	transactions = append(transactions, storage.RefillTransaction{
+		TransactionId:  transactionId,
+		Source:         source,
+		PoolId:         poolId,
+		ItemId:         itemId,
+		ProcessedAt:    now / 1000,
+		Response:       responseJSON,
+		PendingConsume: pendingConsume,
+	})
+
+	// Keep only the most recent transactions, dropping the oldest ones that are not pending
+	if excess := len(transactions) - maxRefillTransactions; excess > 0 {
+		kept := transactions[:0]
+		for _, transaction := range transactions {
+			if excess > 0 && !transaction.PendingConsume {
+				excess--
+				continue
+			}
+			kept = append(kept, transaction)
+		}
+		transactions = kept
+	}
+	data.RefillTransactions = transactions
+
+	return nil
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"extend-custom-guild-service/pkg/economy"
+	"extend-custom-guild-service/pkg/storage"
+	"math/bits"
+)
+
+// Regeneration works in Unix milliseconds. The progress towards the next energy point is stored
+// with the pool and carried over by every change, so consuming, refilling or changing the
+// limits never gains or loses partial regeneration. The only exception is a pool at or above
+// max energy: regeneration is paused there and the next point starts from zero progress.
+
+// regenUnit returns the progress one energy point takes at a regen rate
+func regenUnit(regenRateSeconds int32) int64 {
+	return int64(regenRateSeconds) * 1000 * baseMultiplierPercent
+}
+
+// poolRegen is the state of a pool regenerated up to a point in time
+type poolRegen struct {
+	energy           int32
+	progress         int64 // Towards the next point, always 0 at or above max energy
+	maxEnergy        int32
+	regenRateSeconds int32
+	lastUpdateTime   int64 // Unix milliseconds of the stored state
+	schedule         regenSchedule
+}
+
+// regeneratePool applies regeneration to a pool from its last update up to now
+func regeneratePool(config *economy.Config, data *storage.EnergyData, poolId string, now int64) poolRegen {
+	energy, lastUpdateTime, progress := getPoolEnergy(config, data, poolId, now)
+	maxEnergy, regenRateSeconds := poolLimits(config, data, poolId)
+	regen := poolRegen{
+		energy:           energy,
+		maxEnergy:        maxEnergy,
+		regenRateSeconds: regenRateSeconds,
+		lastUpdateTime:   lastUpdateTime,
+		schedule:         regenScheduleFor(data, poolId),
+	}
+
+	unit := regenUnit(regenRateSeconds)
+	if energy >= maxEnergy || unit <= 0 {
+		return regen
+	}
+
+	if now > lastUpdateTime {
+		progress += regen.schedule.progress(lastUpdateTime, now)
+	}
+
+	// Apply regeneration (capped at max)
+	points := min(progress/unit, int64(maxEnergy-energy))
+	regen.energy += int32(points)
+	if regen.energy < maxEnergy {
+		regen.progress = progress - points*unit
+	}
+
+	return regen
+}
+
+// storePoolEnergy stores energy as the pool's energy at now together with the progress towards
+// the next point. The progress is dropped at or above max energy, where regeneration is paused.
+func storePoolEnergy(
+	config *economy.Config, data *storage.EnergyData, poolId string, energy int32, progress int64, now int64,
+) {
+	if maxEnergy, _ := poolLimits(config, data, poolId); energy >= maxEnergy {
+		progress = 0
+	}
+
+	// A clock behind the last update (another instance, a lowered debug time offset)
+	// must not make the time in between regenerate twice
+	_, lastUpdateTime, _ := getPoolEnergy(config, data, poolId, now)
+
+	setPoolEnergy(data, poolId, energy, max(now, lastUpdateTime), progress)
+}
+
+// changeEnergyLimits banks the default pool's regenerated energy at the current limits and
+// applies change. The progress towards the next point is carried over as the same fraction
+// of a point at the new regen rate.
+func changeEnergyLimits(config *economy.Config, data *storage.EnergyData, now int64, change func()) {
+	before := regeneratePool(config, data, economy.DefaultPoolID, now)
+	change()
+
+	_, regenRateSeconds := energyLimits(config, data)
+	progress := scaleProgress(before.progress, regenUnit(before.regenRateSeconds), regenUnit(regenRateSeconds))
+	storePoolEnergy(config, data, economy.DefaultPoolID, before.energy, progress, now)
+}
+
+// scaleProgress converts progress towards a point of fromUnit into the same fraction of toUnit,
+// rounding down. progress must be below fromUnit.
+func scaleProgress(progress int64, fromUnit int64, toUnit int64) int64 {
+	if progress <= 0 || fromUnit <= 0 || toUnit <= 0 {
+		return 0
+	}
+
+	// The intermediate product can exceed 64 bits for long regen rates
+	hi, lo := bits.Mul64(uint64(progress), uint64(toUnit))
+	scaled, _ := bits.Div64(hi, lo, uint64(fromUnit))
+
+	return int64(scaled)
+}
+
+// ceilSeconds converts Unix milliseconds or a duration in milliseconds to whole seconds,
+// rounding up so a client polling at that second sees the change
+func ceilSeconds(millis int64) int64 {
+	return (millis + 999) / 1000
+}
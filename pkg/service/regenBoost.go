@@ -0,0 +1,141 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"extend-custom-guild-service/pkg/storage"
+	"math"
+	"time"
+)
+
+// Regen progress is measured in milliseconds times the multiplier percent,
+// so one energy point takes regenUnit(regenRateSeconds) units
+const baseMultiplierPercent = 100
+
+// namespaceEventRetention is how long ended namespace events are kept. A player last updated
+// before an event pruned past it regenerates without the event, which only matters for pools
+// that take longer than the retention to fill, any other pool is full by then either way.
+const namespaceEventRetention = 30 * 24 * time.Hour
+
+// regenSchedule is the regen speed of one pool over time, given by the boosts that apply to it.
+// All times are Unix milliseconds.
+type regenSchedule struct {
+	windows []regenWindow
+}
+
+type regenWindow struct {
+	start             int64
+	end               int64
+	multiplierPercent int64
+}
+
+// regenScheduleFor returns the schedule of a pool from the player's boosts and the namespace events
+func regenScheduleFor(data *storage.EnergyData, poolId string) regenSchedule {
+	var windows []regenWindow
+	for _, list := range [][]storage.RegenBoost{data.RegenBoosts, data.NamespaceBoosts} {
+		for _, boost := range list {
+			if boost.PoolId == "" || boost.PoolId == poolId {
+				windows = append(windows, regenWindow{
+					start:             boost.StartTime * 1000,
+					end:               boost.EndTime * 1000,
+					multiplierPercent: int64(boost.MultiplierPercent),
+				})
+			}
+		}
+	}
+
+	return regenSchedule{windows: windows}
+}
+
+// multiplierAt returns the regen speed in percent at time t, overlapping boosts multiply
+func (r regenSchedule) multiplierAt(t int64) int64 {
+	multiplier := int64(baseMultiplierPercent)
+	for _, window := range r.windows {
+		if window.start <= t && t < window.end {
+			multiplier = multiplier * window.multiplierPercent / baseMultiplierPercent
+		}
+	}
+
+	// Regen never stops completely, even with stacked slow-downs
+	return max(multiplier, 1)
+}
+
+// nextChange returns the first time after t at which the multiplier may change
+func (r regenSchedule) nextChange(t int64) int64 {
+	next := int64(math.MaxInt64)
+	for _, window := range r.windows {
+		if window.start > t && window.start < next {
+			next = window.start
+		}
+		if window.end > t && window.end < next {
+			next = window.end
+		}
+	}
+
+	return next
+}
+
+// progress returns the regen progress made between from and to, integrating over boost windows
+func (r regenSchedule) progress(from int64, to int64) int64 {
+	var total int64
+	for t := from; t < to; {
+		segmentEnd := min(r.nextChange(t), to)
+		total += (segmentEnd - t) * r.multiplierAt(t)
+		t = segmentEnd
+	}
+
+	return total
+}
+
+// advance returns the earliest time at which the progress made since from reaches units
+func (r regenSchedule) advance(from int64, units int64) int64 {
+	t := from
+	for units > 0 {
+		multiplier := r.multiplierAt(t)
+		segmentEnd := r.nextChange(t)
+
+		// Round up, the point is only complete once all its progress is made
+		needed := (units + multiplier - 1) / multiplier
+		if segmentEnd == math.MaxInt64 || t+needed <= segmentEnd {
+			return t + needed
+		}
+
+		units -= (segmentEnd - t) * multiplier
+		t = segmentEnd
+	}
+
+	return t
+}
+
+// pruneRegenBoosts drops player boosts that ended before every pool's last update,
+// the progress made during them is already carried in the stored regen progress
+func pruneRegenBoosts(data *storage.EnergyData) {
+	oldestUpdate := lastUpdateMillis(data.LastUpdateTime, data.LastUpdateTimeMs)
+	for _, pool := range data.Pools {
+		oldestUpdate = min(oldestUpdate, lastUpdateMillis(pool.LastUpdateTime, pool.LastUpdateTimeMs))
+	}
+
+	boosts := data.RegenBoosts[:0]
+	for _, boost := range data.RegenBoosts {
+		if boost.EndTime*1000 > oldestUpdate {
+			boosts = append(boosts, boost)
+		}
+	}
+	data.RegenBoosts = boosts
+}
+
+// pruneNamespaceBoosts returns the namespace events that ended within the retention
+func pruneNamespaceBoosts(boosts []storage.RegenBoost, now time.Time) []storage.RegenBoost {
+	horizon := now.Add(-namespaceEventRetention).Unix()
+
+	var kept []storage.RegenBoost
+	for _, boost := range boosts {
+		if boost.EndTime >= horizon {
+			kept = append(kept, boost)
+		}
+	}
+
+	return kept
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"extend-custom-guild-service/pkg/storage"
+)
+
+func TestPruneNamespaceBoosts(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	horizon := now.Add(-namespaceEventRetention).Unix()
+
+	boosts := []storage.RegenBoost{
+		{BoostId: "ended before the horizon", StartTime: horizon - 3600, EndTime: horizon - 1},
+		{BoostId: "ended at the horizon", StartTime: horizon - 3600, EndTime: horizon},
+		{BoostId: "ended after the horizon", StartTime: horizon, EndTime: now.Unix() - 1},
+		{BoostId: "running", StartTime: now.Unix() - 60, EndTime: now.Unix() + 60},
+		{BoostId: "upcoming", StartTime: now.Unix() + 60, EndTime: now.Unix() + 120},
+	}
+
+	kept := pruneNamespaceBoosts(boosts, now)
+
+	want := []string{"ended at the horizon", "ended after the horizon", "running", "upcoming"}
+	if len(kept) != len(want) {
+		t.Fatalf("kept %v, want %v", kept, want)
+	}
+	for i, boost := range kept {
+		if boost.BoostId != want[i] {
+			t.Errorf("kept[%d] = %q, want %q", i, boost.BoostId, want[i])
+		}
+	}
+
+	// The events passed in are left as they were
+	if boosts[0].BoostId != "ended before the horizon" || len(boosts) != 5 {
+		t.Errorf("boosts were changed: %v", boosts)
+	}
+}
@@ -0,0 +1,248 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"extend-custom-guild-service/pkg/economy"
+	pb "extend-custom-guild-service/pkg/pb"
+	"extend-custom-guild-service/pkg/storage"
+)
+
+const (
+	testNamespace = "test"
+	testUserId    = "player"
+)
+
+// testStart is the time every test begins at, the player is created full at it
+var testStart = time.Unix(1_700_000_000, 0)
+
+// loadTestConfig returns the repo economy config
+func loadTestConfig(t *testing.T) *economy.Config {
+	t.Helper()
+
+	config, err := economy.LoadFile("../../config/economy.yaml")
+	if err != nil {
+		t.Fatalf("load economy config: %v", err)
+	}
+
+	return config
+}
+
+// newTestServer returns a service on memory storage with the repo economy config,
+// reading the time from the returned clock
+func newTestServer(t *testing.T, opts ...Option) (*EnergyServiceServerImpl, *storage.MemoryStorage, *FakeClock) {
+	t.Helper()
+
+	return newTestServerWithConfig(t, loadTestConfig(t), opts...)
+}
+
+// newTestServerWithConfig is newTestServer with another economy config
+func newTestServerWithConfig(
+	t *testing.T, config *economy.Config, opts ...Option,
+) (*EnergyServiceServerImpl, *storage.MemoryStorage, *FakeClock) {
+	t.Helper()
+
+	store := storage.NewMemoryStorage()
+	clock := NewFakeClock(testStart)
+	s := NewEnergyServiceServer(nil, nil, nil, store, economy.NewStaticProvider(config),
+		append([]Option{WithClock(clock)}, opts...)...)
+
+	return s, store, clock
+}
+
+// regenStep is one thing that happens to the player during a regen test
+type regenStep func(t *testing.T, s *EnergyServiceServerImpl, clock *FakeClock)
+
+func advance(d time.Duration) regenStep {
+	return func(_ *testing.T, _ *EnergyServiceServerImpl, clock *FakeClock) {
+		clock.Advance(d)
+	}
+}
+
+// fight consumes the 10 energy a fight costs
+func fight() regenStep {
+	return func(t *testing.T, s *EnergyServiceServerImpl, _ *FakeClock) {
+		resp, err := s.ConsumeMyEnergy(context.Background(), &pb.ConsumeMyEnergyRequest{
+			Namespace: testNamespace, UserId: testUserId, ActionType: "fight",
+		})
+		if err != nil || !resp.Success {
+			t.Fatalf("fight: %v %v", resp, err)
+		}
+	}
+}
+
+func refill(amount int32, source string) regenStep {
+	return func(t *testing.T, s *EnergyServiceServerImpl, _ *FakeClock) {
+		resp, err := s.RefillEnergy(context.Background(), &pb.RefillEnergyRequest{
+			Namespace: testNamespace, UserId: testUserId, Amount: amount, Source: source,
+		})
+		if err != nil || !resp.Success {
+			t.Fatalf("refill: %v %v", resp, err)
+		}
+	}
+}
+
+func setRegenRate(regenRateSeconds int32) regenStep {
+	return func(t *testing.T, s *EnergyServiceServerImpl, _ *FakeClock) {
+		_, err := s.UpdateEnergyConfig(context.Background(), &pb.UpdateEnergyConfigRequest{
+			Namespace: testNamespace, UserId: testUserId, RegenRateSeconds: regenRateSeconds,
+		})
+		if err != nil {
+			t.Fatalf("update energy config: %v", err)
+		}
+	}
+}
+
+// holdAndRelease reserves a fight, waits d and releases the hold again
+func holdAndRelease(d time.Duration) regenStep {
+	return func(t *testing.T, s *EnergyServiceServerImpl, clock *FakeClock) {
+		resp, err := s.ReserveEnergy(context.Background(), &pb.ReserveEnergyRequest{
+			Namespace: testNamespace, UserId: testUserId, ActionType: "fight",
+		})
+		if err != nil || !resp.Success {
+			t.Fatalf("reserve: %v %v", resp, err)
+		}
+
+		clock.Advance(d)
+
+		_, err = s.ReleaseEnergy(context.Background(), &pb.ReleaseEnergyRequest{
+			Namespace: testNamespace, UserId: testUserId, HoldId: resp.Hold.HoldId,
+		})
+		if err != nil {
+			t.Fatalf("release: %v", err)
+		}
+	}
+}
+
+// boost gives the player a regen boost starting after delay
+func boost(multiplierPercent int32, delay time.Duration, duration time.Duration) regenStep {
+	return func(t *testing.T, s *EnergyServiceServerImpl, clock *FakeClock) {
+		_, err := s.CreateRegenBoost(context.Background(), &pb.CreateRegenBoostRequest{
+			Namespace:         testNamespace,
+			UserId:            testUserId,
+			MultiplierPercent: multiplierPercent,
+			StartTime:         clock.Now().Add(delay).Unix(),
+			DurationSeconds:   int64(duration / time.Second),
+		})
+		if err != nil {
+			t.Fatalf("create regen boost: %v", err)
+		}
+	}
+}
+
+// The default pool has max 100 energy and regenerates a point every 300 seconds
+func TestRegeneration(t *testing.T) {
+	tests := []struct {
+		name  string
+		steps []regenStep
+		// Stored state after the last step
+		wantEnergy     int32
+		wantProgressMs int64 // Progress towards the next point at normal speed
+	}{
+		{
+			name:       "consume at max starts regen from zero",
+			steps:      []regenStep{fight()},
+			wantEnergy: 90,
+		},
+		{
+			name:           "consume below max carries progress",
+			steps:          []regenStep{fight(), advance(100 * time.Second), fight()},
+			wantEnergy:     80,
+			wantProgressMs: 100_000,
+		},
+		{
+			name:           "refill below max carries progress",
+			steps:          []regenStep{fight(), advance(400 * time.Second), refill(5, "reward")},
+			wantEnergy:     96,
+			wantProgressMs: 100_000,
+		},
+		{
+			name:       "refill up to max drops progress",
+			steps:      []regenStep{fight(), advance(400 * time.Second), refill(9, "reward")},
+			wantEnergy: 100,
+		},
+		{
+			name:           "rate change mid-cycle keeps the fraction of a point",
+			steps:          []regenStep{fight(), advance(150 * time.Second), setRegenRate(600)},
+			wantEnergy:     90,
+			wantProgressMs: 300_000,
+		},
+		{
+			name: "rate change mid-cycle regenerates at the new rate",
+			steps: []regenStep{
+				fight(), advance(150 * time.Second), setRegenRate(600), advance(400 * time.Second), fight(),
+			},
+			wantEnergy:     81,
+			wantProgressMs: 100_000,
+		},
+		{
+			name:       "overflow above max pauses regen",
+			steps:      []regenStep{fight(), advance(100 * time.Second), refill(50, "purchase"), advance(time.Hour)},
+			wantEnergy: 140,
+		},
+		{
+			name: "regen resumes from zero below max after overflow",
+			steps: []regenStep{
+				fight(), advance(100 * time.Second), refill(50, "purchase"), advance(time.Hour),
+				fight(), fight(), fight(), fight(), fight(), advance(60 * time.Second), fight(),
+			},
+			wantEnergy:     80,
+			wantProgressMs: 60_000,
+		},
+		{
+			name:           "released hold refunds and carries progress",
+			steps:          []regenStep{fight(), advance(100 * time.Second), holdAndRelease(100 * time.Second)},
+			wantEnergy:     90,
+			wantProgressMs: 200_000,
+		},
+		{
+			name: "boost ending exactly on a point",
+			steps: []regenStep{
+				fight(), boost(200, 100*time.Second, 100*time.Second), advance(200 * time.Second), fight(),
+			},
+			wantEnergy: 81,
+		},
+		{
+			name: "boost window is integrated across its boundaries",
+			steps: []regenStep{
+				fight(), boost(200, 100*time.Second, 100*time.Second), advance(250 * time.Second), fight(),
+			},
+			wantEnergy:     81,
+			wantProgressMs: 50_000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, store, clock := newTestServer(t)
+			for _, step := range tt.steps {
+				step(t, s, clock)
+			}
+
+			// Reading the energy must not change the stored state
+			if _, err := s.GetMyEnergy(context.Background(), &pb.GetMyEnergyRequest{
+				Namespace: testNamespace, UserId: testUserId,
+			}); err != nil {
+				t.Fatalf("get energy: %v", err)
+			}
+
+			data, err := store.GetEnergyData(context.Background(), testNamespace, testUserId)
+			if err != nil {
+				t.Fatalf("get energy data: %v", err)
+			}
+			if data.CurrentEnergy != tt.wantEnergy {
+				t.Errorf("CurrentEnergy = %d, want %d", data.CurrentEnergy, tt.wantEnergy)
+			}
+			if data.RegenProgress != tt.wantProgressMs*baseMultiplierPercent {
+				t.Errorf("RegenProgress = %d ms, want %d ms",
+					data.RegenProgress/baseMultiplierPercent, tt.wantProgressMs)
+			}
+		})
+	}
+}
@@ -0,0 +1,261 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/AccelByte/accelbyte-go-sdk/cloudsave-sdk/pkg/cloudsaveclient/admin_concurrent_record"
+	"github.com/AccelByte/accelbyte-go-sdk/cloudsave-sdk/pkg/cloudsaveclient/admin_game_record"
+	"github.com/AccelByte/accelbyte-go-sdk/cloudsave-sdk/pkg/cloudsaveclientmodels"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/cloudsave"
+	"github.com/go-openapi/strfmt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LedgerEntry records one change of a player's energy or inventory and why it happened
+type LedgerEntry struct {
+	Sequence      int64              `json:"sequence"` // Increases with every entry of the player
+	EntryId       string             `json:"entryId"`
+	Timestamp     int64              `json:"timestamp"`         // Unix milliseconds
+	Operation     string             `json:"operation"`         // RPC that made the change, e.g. ConsumeMyEnergy
+	Actor         string             `json:"actor"`             // player or admin
+	ActorId       string             `json:"actorId,omitempty"` // User or client ID of the caller
+	ActionType    string             `json:"actionType,omitempty"`
+	ActionId      string             `json:"actionId,omitempty"` // e.g. the hold or boost ID
+	Source        string             `json:"source,omitempty"`
+	TransactionId string             `json:"transactionId,omitempty"`
+	Reason        string             `json:"reason,omitempty"` // Given for an item spend, grant or revoke
+	Pools         []LedgerPoolChange `json:"pools,omitempty"`  // Pools whose energy changed
+	Items         map[string]int32   `json:"items,omitempty"`  // item_id -> quantity change, loot granted is positive
+}
+
+// LedgerPoolChange is the change of one energy pool in a ledger entry
+type LedgerPoolChange struct {
+	PoolId        string `json:"poolId"`
+	Delta         int32  `json:"delta"`
+	BalanceBefore int32  `json:"balanceBefore"`
+	BalanceAfter  int32  `json:"balanceAfter"`
+}
+
+// LedgerQuery selects a page of a player's ledger entries, newest first
+type LedgerQuery struct {
+	StartTime      int64 // Unix milliseconds, entries at or after it (0 = no limit)
+	EndTime        int64 // Unix milliseconds, entries before it (0 = no limit)
+	BeforeSequence int64 // Entries with a lower sequence, to continue a previous page (0 = newest)
+	Limit          int
+}
+
+// LedgerStore keeps the ledger of every player's energy and inventory changes
+type LedgerStore interface {
+	// AppendLedgerEntries adds entries to the player's ledger in order, assigning their Sequence.
+	// Entries whose EntryId the ledger already has are skipped, so appending again is safe.
+	AppendLedgerEntries(ctx context.Context, namespace string, userId string, entries []LedgerEntry) error
+	// ListLedgerEntries returns the player's entries matching query newest first, and whether more match
+	ListLedgerEntries(ctx context.Context, namespace string, userId string, query LedgerQuery) ([]LedgerEntry, bool, error)
+}
+
+// maxLedgerEntries is how many entries are kept per player, older entries are dropped
+const maxLedgerEntries = 500
+
+// ledgerValue is the stored ledger of a player, oldest entry first
+type ledgerValue struct {
+	Entries []LedgerEntry `json:"entries"`
+}
+
+// appendLedgerEntries adds the entries not stored yet after the stored ones, numbering them on
+// from the last stored entry, and drops the oldest entries beyond maxLedgerEntries.
+// It reports whether any entry was added.
+func appendLedgerEntries(stored []LedgerEntry, entries []LedgerEntry) ([]LedgerEntry, bool) {
+	var sequence int64
+	if len(stored) > 0 {
+		sequence = stored[len(stored)-1].Sequence
+	}
+
+	storedIds := make(map[string]bool, len(stored))
+	for _, entry := range stored {
+		storedIds[entry.EntryId] = true
+	}
+
+	ledger := make([]LedgerEntry, 0, len(stored)+len(entries))
+	ledger = append(ledger, stored...)
+	for _, entry := range entries {
+		if storedIds[entry.EntryId] {
+			continue
+		}
+		storedIds[entry.EntryId] = true
+
+		sequence++
+		entry.Sequence = sequence
+		ledger = append(ledger, entry)
+	}
+	added := len(ledger) > len(stored)
+
+	if len(ledger) > maxLedgerEntries {
+		ledger = ledger[len(ledger)-maxLedgerEntries:]
+	}
+
+	return ledger, added
+}
+
+// pageLedgerEntries selects the entries of a ledger stored oldest first matching query, newest first
+func pageLedgerEntries(ledger []LedgerEntry, query LedgerQuery) ([]LedgerEntry, bool) {
+	var page []LedgerEntry
+	for i := len(ledger) - 1; i >= 0; i-- {
+		entry := ledger[i]
+		if query.BeforeSequence > 0 && entry.Sequence >= query.BeforeSequence {
+			continue
+		}
+		if query.EndTime > 0 && entry.Timestamp >= query.EndTime {
+			continue
+		}
+		// Timestamps are not monotonic, e.g. after a debug time offset was lowered,
+		// so older entries may still be in range
+		if entry.Timestamp < query.StartTime {
+			continue
+		}
+
+		if len(page) == query.Limit {
+			return page, true
+		}
+		page = append(page, entry)
+	}
+
+	return page, false
+}
+
+// maxLedgerSaveAttempts bounds the retries of a ledger append that keeps conflicting
+const maxLedgerSaveAttempts = 5
+
+// CloudsaveLedgerStore implements LedgerStore with one CloudSave record per player
+type CloudsaveLedgerStore struct {
+	csStorage           *cloudsave.AdminGameRecordService
+	csConcurrentStorage *cloudsave.AdminConcurrentRecordService
+}
+
+// NewCloudSaveLedgerStore creates a new CloudSave ledger store
+func NewCloudSaveLedgerStore(
+	csStorage *cloudsave.AdminGameRecordService,
+	csConcurrentStorage *cloudsave.AdminConcurrentRecordService,
+) *CloudsaveLedgerStore {
+	return &CloudsaveLedgerStore{
+		csStorage:           csStorage,
+		csConcurrentStorage: csConcurrentStorage,
+	}
+}
+
+// getLedgerKey returns the CloudSave key for a player's ledger
+func getLedgerKey(userId string) string {
+	return "energy_ledger_" + userId
+}
+
+// AppendLedgerEntries adds entries to the player's ledger record, retrying when it was changed
+// or created concurrently. ErrConflict is returned when every attempt conflicted.
+func (c *CloudsaveLedgerStore) AppendLedgerEntries(
+	ctx context.Context, namespace string, userId string, entries []LedgerEntry,
+) error {
+	for attempt := 1; ; attempt++ {
+		stored, updatedAt, err := c.getLedger(ctx, namespace, userId)
+		if errors.Is(err, ErrNotFound) {
+			// Only create the record if no other request created it meanwhile
+			updatedAt = createPrecondition
+		} else if err != nil {
+			return err
+		}
+
+		ledger, added := appendLedgerEntries(stored, entries)
+		if !added {
+			return nil
+		}
+
+		err = c.saveLedgerConcurrent(ctx, namespace, userId, ledgerValue{Entries: ledger}, updatedAt)
+		if errors.Is(err, ErrConflict) && attempt < maxLedgerSaveAttempts {
+			continue
+		}
+
+		return err
+	}
+}
+
+// ListLedgerEntries reads the player's ledger record and returns the entries matching query
+func (c *CloudsaveLedgerStore) ListLedgerEntries(
+	ctx context.Context, namespace string, userId string, query LedgerQuery,
+) ([]LedgerEntry, bool, error) {
+	stored, _, err := c.getLedger(ctx, namespace, userId)
+	if errors.Is(err, ErrNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	entries, more := pageLedgerEntries(stored, query)
+
+	return entries, more, nil
+}
+
+// getLedger returns the stored entries of a player with the record version
+func (c *CloudsaveLedgerStore) getLedger(
+	ctx context.Context, namespace string, userId string,
+) ([]LedgerEntry, time.Time, error) {
+	input := &admin_game_record.AdminGetGameRecordHandlerV1Params{
+		Key:       getLedgerKey(userId),
+		Namespace: namespace,
+		Context:   ctx,
+	}
+
+	response, err := c.csStorage.AdminGetGameRecordHandlerV1Short(input)
+	if err != nil {
+		return nil, time.Time{}, getRecordError(err)
+	}
+
+	valueJSON, err := json.Marshal(response.Value)
+	if err != nil {
+		return nil, time.Time{}, status.Errorf(codes.Internal, "Error marshalling value into JSON: %v", err)
+	}
+
+	var value ledgerValue
+	err = json.Unmarshal(valueJSON, &value)
+	if err != nil {
+		return nil, time.Time{}, status.Errorf(codes.Internal, "Error unmarshalling value into ledger: %v", err)
+	}
+
+	return value.Entries, time.Time(response.UpdatedAt), nil
+}
+
+// saveLedgerConcurrent replaces the ledger record only if it is still the version read at updatedAt,
+// or creates it if updatedAt is createPrecondition and there is no record
+func (c *CloudsaveLedgerStore) saveLedgerConcurrent(
+	ctx context.Context, namespace string, userId string, value ledgerValue, updatedAt time.Time,
+) error {
+	setBy := cloudsaveclientmodels.ModelsAdminConcurrentRecordRequestSetBySERVER
+
+	input := &admin_concurrent_record.AdminPutGameRecordConcurrentHandlerV1Params{
+		Body: &cloudsaveclientmodels.ModelsAdminConcurrentRecordRequest{
+			SetBy:     &setBy,
+			UpdatedAt: strfmt.DateTime(updatedAt),
+			Value:     value,
+		},
+		Key:       getLedgerKey(userId),
+		Namespace: namespace,
+		Context:   ctx,
+	}
+
+	err := c.csConcurrentStorage.AdminPutGameRecordConcurrentHandlerV1Short(input)
+	if err != nil {
+		var preconditionFailed *admin_concurrent_record.AdminPutGameRecordConcurrentHandlerV1PreconditionFailed
+		if errors.As(err, &preconditionFailed) {
+			return ErrConflict
+		}
+
+		return status.Errorf(codes.Internal, "Error saving ledger: %v", err)
+	}
+
+	return nil
+}
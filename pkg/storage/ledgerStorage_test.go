@@ -0,0 +1,87 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package storage
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAppendLedgerEntriesSkipsStoredEntries(t *testing.T) {
+	ledger, added := appendLedgerEntries(nil, []LedgerEntry{{EntryId: "a"}, {EntryId: "b"}})
+	if !added || len(ledger) != 2 {
+		t.Fatalf("appendLedgerEntries = %v, %v", ledger, added)
+	}
+
+	// Appending entries again after a failed attempt only adds the new ones
+	ledger, added = appendLedgerEntries(ledger, []LedgerEntry{{EntryId: "b"}, {EntryId: "c"}})
+	if !added {
+		t.Fatal("entry c was not added")
+	}
+	var sequences []int64
+	for _, entry := range ledger {
+		sequences = append(sequences, entry.Sequence)
+	}
+	if !reflect.DeepEqual(sequences, []int64{1, 2, 3}) {
+		t.Errorf("sequences = %v, want [1 2 3]", sequences)
+	}
+
+	if _, added = appendLedgerEntries(ledger, []LedgerEntry{{EntryId: "c"}}); added {
+		t.Error("stored entry c was added again")
+	}
+}
+
+func TestPageLedgerEntries(t *testing.T) {
+	// Stored oldest first, the clock went back between sequence 2 and 3
+	ledger := []LedgerEntry{
+		{Sequence: 1, Timestamp: 1000},
+		{Sequence: 2, Timestamp: 5000},
+		{Sequence: 3, Timestamp: 2000},
+		{Sequence: 4, Timestamp: 6000},
+	}
+
+	tests := []struct {
+		name          string
+		query         LedgerQuery
+		wantSequences []int64
+		wantMore      bool
+	}{
+		{
+			name:          "everything newest first",
+			query:         LedgerQuery{Limit: 10},
+			wantSequences: []int64{4, 3, 2, 1},
+		},
+		{
+			name:          "start time skips older entries without stopping",
+			query:         LedgerQuery{StartTime: 4000, Limit: 10},
+			wantSequences: []int64{4, 2},
+		},
+		{
+			name:          "end time",
+			query:         LedgerQuery{EndTime: 5000, Limit: 10},
+			wantSequences: []int64{3, 1},
+		},
+		{
+			name:          "page after a sequence",
+			query:         LedgerQuery{BeforeSequence: 4, Limit: 2},
+			wantSequences: []int64{3, 2},
+			wantMore:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, more := pageLedgerEntries(ledger, tt.query)
+
+			var sequences []int64
+			for _, entry := range page {
+				sequences = append(sequences, entry.Sequence)
+			}
+			if !reflect.DeepEqual(sequences, tt.wantSequences) || more != tt.wantMore {
+				t.Errorf("pageLedgerEntries = %v, %v, want %v, %v", sequences, more, tt.wantSequences, tt.wantMore)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package storage
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryLedgerStore implements LedgerStore in process memory.
+// Intended for local development and tests, entries are lost on restart.
+type MemoryLedgerStore struct {
+	mu      sync.RWMutex
+	ledgers map[string][]LedgerEntry
+}
+
+// NewMemoryLedgerStore creates a new empty in-memory ledger store
+func NewMemoryLedgerStore() *MemoryLedgerStore {
+	return &MemoryLedgerStore{ledgers: make(map[string][]LedgerEntry)}
+}
+
+// AppendLedgerEntries adds copies of the entries the player's ledger does not have yet
+func (m *MemoryLedgerStore) AppendLedgerEntries(
+	_ context.Context, namespace string, userId string, entries []LedgerEntry,
+) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	copies := make([]LedgerEntry, len(entries))
+	for i, entry := range entries {
+		copies[i] = copyLedgerEntry(entry)
+	}
+
+	key := namespace + "/" + getLedgerKey(userId)
+	m.ledgers[key], _ = appendLedgerEntries(m.ledgers[key], copies)
+
+	return nil
+}
+
+// ListLedgerEntries returns copies of the player's entries matching query
+func (m *MemoryLedgerStore) ListLedgerEntries(
+	_ context.Context, namespace string, userId string, query LedgerQuery,
+) ([]LedgerEntry, bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	entries, more := pageLedgerEntries(m.ledgers[namespace+"/"+getLedgerKey(userId)], query)
+	for i, entry := range entries {
+		entries[i] = copyLedgerEntry(entry)
+	}
+
+	return entries, more, nil
+}
+
+// copyLedgerEntry returns a deep copy of entry so callers never share maps or slices
+func copyLedgerEntry(entry LedgerEntry) LedgerEntry {
+	entry.Pools = append([]LedgerPoolChange(nil), entry.Pools...)
+	if entry.Items != nil {
+		items := make(map[string]int32, len(entry.Items))
+		for itemId, qty := range entry.Items {
+			items[itemId] = qty
+		}
+		entry.Items = items
+	}
+
+	return entry
+}
@@ -0,0 +1,277 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// MemoryStorage implements Storage in process memory.
+// Intended for local development and tests, data is lost on restart.
+type MemoryStorage struct {
+	mu                 sync.RWMutex
+	records            map[string]*EnergyData
+	idempotencyRecords map[string]IdempotencyRecord
+	namespaceConfigs   map[string]NamespaceConfig
+	namespaceBoosts    map[string][]RegenBoost
+	debugTimeOffsets   map[string]int64
+}
+
+// NewMemoryStorage creates a new empty in-memory storage instance
+func NewMemoryStorage() *MemoryStorage {
+	return &MemoryStorage{
+		records:            make(map[string]*EnergyData),
+		idempotencyRecords: make(map[string]IdempotencyRecord),
+		namespaceConfigs:   make(map[string]NamespaceConfig),
+		namespaceBoosts:    make(map[string][]RegenBoost),
+		debugTimeOffsets:   make(map[string]int64),
+	}
+}
+
+// getMemoryKey returns the map key for a player's energy data in a namespace
+func getMemoryKey(namespace string, userId string) string {
+	return namespace + "/" + getEnergyKey(userId)
+}
+
+// GetEnergyData returns a copy of the stored energy data
+// If no data exists, returns ErrNotFound (caller should initialize)
+func (m *MemoryStorage) GetEnergyData(_ context.Context, namespace string, userId string) (*EnergyData, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	data, ok := m.records[getMemoryKey(namespace, userId)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	return copyEnergyData(data), nil
+}
+
+// SaveEnergyData stores a copy of the energy data, honouring the UpdatedAt precondition
+func (m *MemoryStorage) SaveEnergyData(_ context.Context, namespace string, userId string, data *EnergyData) (*EnergyData, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := getMemoryKey(namespace, userId)
+	existing, ok := m.records[key]
+
+	if data.UpdatedAt.IsZero() && ok {
+		return nil, ErrConflict
+	}
+	if !data.UpdatedAt.IsZero() && (!ok || !existing.UpdatedAt.Equal(data.UpdatedAt)) {
+		return nil, ErrConflict
+	}
+
+	stored := copyEnergyData(data)
+	stored.NamespaceBoosts = nil // Not part of the stored value
+	stored.UpdatedAt = time.Now().UTC()
+	// Make sure every save produces a new version, even within the clock resolution
+	if ok && !stored.UpdatedAt.After(existing.UpdatedAt) {
+		stored.UpdatedAt = existing.UpdatedAt.Add(time.Nanosecond)
+	}
+	m.records[key] = stored
+
+	return copyEnergyData(stored), nil
+}
+
+// GetIdempotencyRecord returns a copy of the stored idempotency record
+func (m *MemoryStorage) GetIdempotencyRecord(_ context.Context, namespace string, userId string, key string) (*IdempotencyRecord, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	record, ok := m.idempotencyRecords[namespace+"/"+getIdempotencyKey(userId, key)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	record.Response = append([]byte(nil), record.Response...)
+
+	return &record, nil
+}
+
+// SaveIdempotencyRecord stores a copy of the idempotency record, replacing any existing one
+func (m *MemoryStorage) SaveIdempotencyRecord(_ context.Context, namespace string, userId string, key string, record *IdempotencyRecord) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.storeIdempotencyRecord(namespace+"/"+getIdempotencyKey(userId, key), record)
+
+	return nil
+}
+
+// ClaimIdempotencyRecord stores a copy of the idempotency record, honouring the UpdatedAt precondition
+func (m *MemoryStorage) ClaimIdempotencyRecord(_ context.Context, namespace string, userId string, key string, record *IdempotencyRecord) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	recordKey := namespace + "/" + getIdempotencyKey(userId, key)
+	existing, ok := m.idempotencyRecords[recordKey]
+
+	if record.UpdatedAt.IsZero() && ok {
+		return ErrConflict
+	}
+	if !record.UpdatedAt.IsZero() && (!ok || !existing.UpdatedAt.Equal(record.UpdatedAt)) {
+		return ErrConflict
+	}
+	m.storeIdempotencyRecord(recordKey, record)
+
+	return nil
+}
+
+// DeleteIdempotencyRecord removes the idempotency record, if any
+func (m *MemoryStorage) DeleteIdempotencyRecord(_ context.Context, namespace string, userId string, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.idempotencyRecords, namespace+"/"+getIdempotencyKey(userId, key))
+
+	return nil
+}
+
+// storeIdempotencyRecord stores a copy of the record with a new version, m.mu must be held
+func (m *MemoryStorage) storeIdempotencyRecord(recordKey string, record *IdempotencyRecord) {
+	existing, ok := m.idempotencyRecords[recordKey]
+
+	stored := *record
+	stored.Response = append([]byte(nil), record.Response...)
+	stored.UpdatedAt = time.Now().UTC()
+	if ok && !stored.UpdatedAt.After(existing.UpdatedAt) {
+		stored.UpdatedAt = existing.UpdatedAt.Add(time.Nanosecond)
+	}
+	m.idempotencyRecords[recordKey] = stored
+}
+
+// GetNamespaceConfig returns a copy of the namespace-wide energy config
+func (m *MemoryStorage) GetNamespaceConfig(_ context.Context, namespace string) (*NamespaceConfig, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	config, ok := m.namespaceConfigs[namespace]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	return &config, nil
+}
+
+// SaveNamespaceConfig stores a copy of the namespace-wide energy config, replacing any existing one
+func (m *MemoryStorage) SaveNamespaceConfig(_ context.Context, namespace string, config *NamespaceConfig) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.namespaceConfigs[namespace] = *config
+
+	return nil
+}
+
+// GetNamespaceBoosts returns a copy of the namespace-wide regen events
+func (m *MemoryStorage) GetNamespaceBoosts(_ context.Context, namespace string) ([]RegenBoost, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return copyRegenBoosts(m.namespaceBoosts[namespace]), nil
+}
+
+// SaveNamespaceBoosts stores a copy of the namespace-wide regen events, replacing the existing ones
+func (m *MemoryStorage) SaveNamespaceBoosts(_ context.Context, namespace string, boosts []RegenBoost) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.namespaceBoosts[namespace] = copyRegenBoosts(boosts)
+
+	return nil
+}
+
+// GetDebugTimeOffset returns the namespace debug time offset
+func (m *MemoryStorage) GetDebugTimeOffset(_ context.Context, namespace string) (int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.debugTimeOffsets[namespace], nil
+}
+
+// SaveDebugTimeOffset stores the namespace debug time offset
+func (m *MemoryStorage) SaveDebugTimeOffset(_ context.Context, namespace string, offsetSeconds int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.debugTimeOffsets[namespace] = offsetSeconds
+
+	return nil
+}
+
+// copyEnergyData returns a deep copy of data so callers never share maps or slices
+func copyEnergyData(data *EnergyData) *EnergyData {
+	dataCopy := *data
+	if data.Inventory != nil {
+		dataCopy.Inventory = make(map[string]int32, len(data.Inventory))
+		for itemId, qty := range data.Inventory {
+			dataCopy.Inventory[itemId] = qty
+		}
+	}
+
+	if data.PendingItems != nil {
+		dataCopy.PendingItems = make(map[string]int32, len(data.PendingItems))
+		for itemId, qty := range data.PendingItems {
+			dataCopy.PendingItems[itemId] = qty
+		}
+	}
+
+	if data.Pools != nil {
+		dataCopy.Pools = make(map[string]PoolData, len(data.Pools))
+		for poolId, pool := range data.Pools {
+			dataCopy.Pools[poolId] = pool
+		}
+	}
+
+	dataCopy.RegenBoosts = copyRegenBoosts(data.RegenBoosts)
+	dataCopy.NamespaceBoosts = copyRegenBoosts(data.NamespaceBoosts)
+
+	if data.RefillUsage != nil {
+		dataCopy.RefillUsage = make(map[string]RefillUsage, len(data.RefillUsage))
+		for source, usage := range data.RefillUsage {
+			dataCopy.RefillUsage[source] = usage
+		}
+	}
+
+	if data.Holds != nil {
+		dataCopy.Holds = make([]EnergyHold, len(data.Holds))
+		for i, hold := range data.Holds {
+			hold.Costs = make(map[string]int32, len(hold.Costs))
+			for poolId, cost := range data.Holds[i].Costs {
+				hold.Costs[poolId] = cost
+			}
+			dataCopy.Holds[i] = hold
+		}
+	}
+
+	if data.RefillTransactions != nil {
+		dataCopy.RefillTransactions = make([]RefillTransaction, len(data.RefillTransactions))
+		for i, transaction := range data.RefillTransactions {
+			transaction.Response = append(json.RawMessage(nil), transaction.Response...)
+			dataCopy.RefillTransactions[i] = transaction
+		}
+	}
+
+	if data.PendingLedgerEntries != nil {
+		dataCopy.PendingLedgerEntries = make([]LedgerEntry, len(data.PendingLedgerEntries))
+		for i, entry := range data.PendingLedgerEntries {
+			dataCopy.PendingLedgerEntries[i] = copyLedgerEntry(entry)
+		}
+	}
+
+	return &dataCopy
+}
+
+// copyRegenBoosts returns a copy of boosts, nil when there are none
+func copyRegenBoosts(boosts []RegenBoost) []RegenBoost {
+	if len(boosts) == 0 {
+		return nil
+	}
+
+	return append([]RegenBoost(nil), boosts...)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package storage
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMemoryStorageCopiesNamespaceBoosts(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStorage()
+
+	boosts := []RegenBoost{{BoostId: "happy-hour", MultiplierPercent: 200}}
+	if err := store.SaveNamespaceBoosts(ctx, "test", boosts); err != nil {
+		t.Fatalf("save namespace boosts: %v", err)
+	}
+	boosts[0].MultiplierPercent = 300
+
+	loaded, err := store.GetNamespaceBoosts(ctx, "test")
+	if err != nil {
+		t.Fatalf("get namespace boosts: %v", err)
+	}
+	loaded[0].MultiplierPercent = 400
+
+	stored, err := store.GetNamespaceBoosts(ctx, "test")
+	if err != nil {
+		t.Fatalf("get namespace boosts: %v", err)
+	}
+	if stored[0].MultiplierPercent != 200 {
+		t.Errorf("MultiplierPercent = %d, want 200", stored[0].MultiplierPercent)
+	}
+
+	data := &EnergyData{NamespaceBoosts: stored}
+	copied := copyEnergyData(data)
+	copied.NamespaceBoosts[0].MultiplierPercent = 500
+	if data.NamespaceBoosts[0].MultiplierPercent != 200 {
+		t.Errorf("copy shares NamespaceBoosts with the original")
+	}
+}
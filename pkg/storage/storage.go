@@ -6,11 +6,17 @@ package storage
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"time"
 
+	"github.com/AccelByte/accelbyte-go-sdk/cloudsave-sdk/pkg/cloudsaveclient/admin_concurrent_record"
 	"github.com/AccelByte/accelbyte-go-sdk/cloudsave-sdk/pkg/cloudsaveclient/admin_game_record"
 	"github.com/AccelByte/accelbyte-go-sdk/cloudsave-sdk/pkg/cloudsaveclientmodels"
 	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/cloudsave"
+	"github.com/go-openapi/strfmt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -19,14 +25,127 @@ import (
 type EnergyData struct {
 	UserId           string           `json:"userId"`
 	CurrentEnergy    int32            `json:"currentEnergy"`
-	MaxEnergy        int32            `json:"maxEnergy"`
+	MaxEnergy        int32            `json:"maxEnergy"`        // Level 1 max energy, from the namespace config
 	LastUpdateTime   int64            `json:"lastUpdateTime"`   // Unix timestamp
-	RegenRateSeconds int32            `json:"regenRateSeconds"` // Seconds per energy point
+	RegenRateSeconds int32            `json:"regenRateSeconds"` // Level 1 seconds per energy point
 	Level            int32            `json:"level"`            // Energy system level
 	Inventory        map[string]int32 `json:"inventory"`        // item_id -> quantity
+
+	// Loot that did not fit in the inventory, waiting for the player to claim it
+	PendingItems map[string]int32 `json:"pendingItems,omitempty"` // item_id -> quantity
+
+	// Sub-second regen state of the default pool, see PoolData
+	LastUpdateTimeMs int64 `json:"lastUpdateTimeMs,omitempty"`
+	RegenProgress    int64 `json:"regenProgress,omitempty"`
+
+	// Energy of pools other than the default pool, which uses the fields above.
+	// A pool missing here has not been used yet.
+	Pools map[string]PoolData `json:"pools,omitempty"` // pool_id -> pool energy
+
+	// Per-user limits set by an admin, they take precedence over the level table (0 = not set)
+	MaxEnergyOverride        int32 `json:"maxEnergyOverride,omitempty"`
+	RegenRateSecondsOverride int32 `json:"regenRateSecondsOverride,omitempty"`
+
+	// Regen boosts granted to the player. Ended boosts are kept until every pool
+	// was updated after their end, so the regenerated energy stays exact.
+	RegenBoosts []RegenBoost `json:"regenBoosts,omitempty"`
+
+	// NamespaceBoosts are the namespace-wide regen events, loaded alongside the record
+	// by the service. It is not part of the stored value.
+	NamespaceBoosts []RegenBoost `json:"-"`
+
+	// Energy held for actions that have not resolved yet, see EnergyHold
+	Holds []EnergyHold `json:"holds,omitempty"`
+
+	// How the player used refill sources that have a refill policy and exchange offers
+	RefillUsage map[string]RefillUsage `json:"refillUsage,omitempty"` // source or "exchange:" + offer ID -> usage
+
+	// Recently processed refills, used to replay the original response for a retried transaction
+	RefillTransactions []RefillTransaction `json:"refillTransactions,omitempty"`
+
+	// Ledger entries saved together with the change they record, so they are never lost
+	// when adding them to the ledger fails. They are kept until a later change sees them
+	// in the ledger.
+	PendingLedgerEntries []LedgerEntry `json:"pendingLedgerEntries,omitempty"`
+
+	// UpdatedAt is the storage version of the record, used as the precondition
+	// for the next save. It is not part of the stored value.
+	UpdatedAt time.Time `json:"-"`
+}
+
+// PoolData is the stored energy of an additional energy pool, its limits come from the economy config
+type PoolData struct {
+	CurrentEnergy  int32 `json:"currentEnergy"`
+	LastUpdateTime int64 `json:"lastUpdateTime"` // Unix timestamp
+
+	// LastUpdateTime in Unix milliseconds, records saved before it existed only have LastUpdateTime
+	LastUpdateTimeMs int64 `json:"lastUpdateTimeMs,omitempty"`
+	// Progress towards the next energy point at the last update, carried over so partial
+	// regeneration is never lost. Measured in milliseconds times the regen multiplier percent.
+	RegenProgress int64 `json:"regenProgress,omitempty"`
+}
+
+// RegenBoost multiplies the regeneration speed of energy pools during a time window
+type RegenBoost struct {
+	BoostId           string `json:"boostId"`
+	MultiplierPercent int32  `json:"multiplierPercent"` // 200 = regen twice as fast
+	PoolId            string `json:"poolId,omitempty"`  // Boosted pool, empty for every pool
+	StartTime         int64  `json:"startTime"`         // Unix timestamp
+	EndTime           int64  `json:"endTime"`           // Unix timestamp, exclusive
+	Reason            string `json:"reason,omitempty"`
+}
+
+// EnergyHold is energy deducted for an action that resolves later, e.g. a battle.
+// Committing it keeps the energy spent, releasing it or letting it expire refunds it.
+type EnergyHold struct {
+	HoldId     string           `json:"holdId"`
+	ActionType string           `json:"actionType"`
+	Costs      map[string]int32 `json:"costs"`     // pool_id -> energy held
+	CreatedAt  int64            `json:"createdAt"` // Unix timestamp
+	ExpiresAt  int64            `json:"expiresAt"` // Unix timestamp
+}
+
+// RefillUsage tracks the refills of a player from one source, to enforce its refill policy
+type RefillUsage struct {
+	LastRefillTime int64 `json:"lastRefillTime"` // Unix timestamp
+	DayStart       int64 `json:"dayStart"`       // Unix timestamp the policy day of UsesToday started
+	UsesToday      int32 `json:"usesToday"`
+}
+
+// RefillTransaction records a processed refill keyed by its client transaction ID
+type RefillTransaction struct {
+	TransactionId string          `json:"transactionId"`
+	Source        string          `json:"source"`
+	PoolId        string          `json:"poolId,omitempty"`
+	ItemId        string          `json:"itemId,omitempty"`
+	ProcessedAt   int64           `json:"processedAt"` // Unix timestamp
+	Response      json.RawMessage `json:"response"`    // Original response returned to the client
+	// The purchase behind the refill is not consumed yet, the transaction is kept until it is
+	PendingConsume bool `json:"pendingConsume,omitempty"`
+}
+
+// IdempotencyRecord stores the outcome of a request sent with an Idempotency-Key
+type IdempotencyRecord struct {
+	Method      string `json:"method"`      // Full gRPC method name
+	RequestHash string `json:"requestHash"` // Hash of the request body, to detect a reused key
+	Response    []byte `json:"response"`    // Serialized protobuf response
+	CreatedAt   int64  `json:"createdAt"`   // Unix timestamp
+	ExpiresAt   int64  `json:"expiresAt"`   // Unix timestamp, the record is ignored afterwards
+	// InProgress marks a claimed key whose request is still running, Response is empty
+	InProgress bool `json:"inProgress,omitempty"`
+	// UpdatedAt is the storage version of the record, used as the precondition
+	// when claiming the key. It is not part of the stored value.
+	UpdatedAt time.Time `json:"-"`
+}
+
+// NamespaceConfig holds the energy settings new players in a namespace start with
+type NamespaceConfig struct {
+	MaxEnergy        int32 `json:"maxEnergy"`
+	RegenRateSeconds int32 `json:"regenRateSeconds"` // Seconds per energy point
+	StartingEnergy   int32 `json:"startingEnergy"`
 }
 
-// Default values for new players
+// Default values for new players, used when the namespace has no NamespaceConfig
 const (
 	DefaultMaxEnergy        = 100
 	DefaultRegenRateSeconds = 300 // 5 minutes per energy
@@ -34,21 +153,66 @@ const (
 	DefaultLevel            = 1
 )
 
+// DefaultNamespaceConfig returns the built-in defaults for a namespace without a stored config
+func DefaultNamespaceConfig() *NamespaceConfig {
+	return &NamespaceConfig{
+		MaxEnergy:        DefaultMaxEnergy,
+		RegenRateSeconds: DefaultRegenRateSeconds,
+		StartingEnergy:   DefaultStartingEnergy,
+	}
+}
+
+// ErrNotFound is returned by GetEnergyData when the player has no energy record yet,
+// by GetIdempotencyRecord when the key was not used before and by GetNamespaceConfig
+// when the namespace uses the built-in defaults.
+// It is the only error that means the player is new, any other error is a storage failure.
+var ErrNotFound = status.Error(codes.NotFound, "energy data not found")
+
+// ErrConflict is returned by SaveEnergyData when the stored record was modified
+// after it was read, i.e. data.UpdatedAt no longer matches the stored version,
+// or when a record was created by another request while creating a new one
+var ErrConflict = status.Error(codes.Aborted, "energy data was modified concurrently")
+
 // Storage interface for energy data operations
 type Storage interface {
+	// GetEnergyData returns ErrNotFound when no record exists for the player
 	GetEnergyData(ctx context.Context, namespace string, userId string) (*EnergyData, error)
+	// SaveEnergyData creates the record when data.UpdatedAt is zero and returns ErrConflict
+	// if the player already has one. Otherwise the save only succeeds if the stored record
+	// still has that UpdatedAt, and ErrConflict is returned when it does not.
 	SaveEnergyData(ctx context.Context, namespace string, userId string, data *EnergyData) (*EnergyData, error)
+	// GetIdempotencyRecord returns ErrNotFound when no record exists for the player and key
+	GetIdempotencyRecord(ctx context.Context, namespace string, userId string, key string) (*IdempotencyRecord, error)
+	SaveIdempotencyRecord(ctx context.Context, namespace string, userId string, key string, record *IdempotencyRecord) error
+	// ClaimIdempotencyRecord stores record only when no record exists (record.UpdatedAt is zero)
+	// or the stored record still has record.UpdatedAt, and returns ErrConflict otherwise
+	ClaimIdempotencyRecord(ctx context.Context, namespace string, userId string, key string, record *IdempotencyRecord) error
+	DeleteIdempotencyRecord(ctx context.Context, namespace string, userId string, key string) error
+	// GetNamespaceConfig returns ErrNotFound when the namespace has no stored config
+	GetNamespaceConfig(ctx context.Context, namespace string) (*NamespaceConfig, error)
+	SaveNamespaceConfig(ctx context.Context, namespace string, config *NamespaceConfig) error
+	// GetNamespaceBoosts returns the namespace-wide regen events, empty when there are none
+	GetNamespaceBoosts(ctx context.Context, namespace string) ([]RegenBoost, error)
+	SaveNamespaceBoosts(ctx context.Context, namespace string, boosts []RegenBoost) error
+	// GetDebugTimeOffset returns how many seconds the namespace clock runs ahead, 0 when not set
+	GetDebugTimeOffset(ctx context.Context, namespace string) (int64, error)
+	SaveDebugTimeOffset(ctx context.Context, namespace string, offsetSeconds int64) error
 }
 
 // CloudsaveStorage implements Storage using AccelByte CloudSave
 type CloudsaveStorage struct {
-	csStorage *cloudsave.AdminGameRecordService
+	csStorage           *cloudsave.AdminGameRecordService
+	csConcurrentStorage *cloudsave.AdminConcurrentRecordService
 }
 
 // NewCloudSaveStorage creates a new CloudSave storage instance
-func NewCloudSaveStorage(csStorage *cloudsave.AdminGameRecordService) *CloudsaveStorage {
+func NewCloudSaveStorage(
+	csStorage *cloudsave.AdminGameRecordService,
+	csConcurrentStorage *cloudsave.AdminConcurrentRecordService,
+) *CloudsaveStorage {
 	return &CloudsaveStorage{
-		csStorage: csStorage,
+		csStorage:           csStorage,
+		csConcurrentStorage: csConcurrentStorage,
 	}
 }
 
@@ -57,32 +221,81 @@ func getEnergyKey(userId string) string {
 	return "energy_" + userId
 }
 
+// namespaceConfigKey is the CloudSave key of the namespace-wide energy config.
+// User IDs never contain underscores, so it cannot collide with a player's energy key.
+const namespaceConfigKey = "energy_namespace_config"
+
+// namespaceBoostsKey is the CloudSave key of the namespace-wide regen events
+const namespaceBoostsKey = "energy_namespace_boosts"
+
+// namespaceBoostsValue is the stored CloudSave value of the namespace-wide regen events
+type namespaceBoostsValue struct {
+	Boosts []RegenBoost `json:"boosts"`
+}
+
+// debugTimeOffsetKey is the CloudSave key of the namespace debug time offset
+const debugTimeOffsetKey = "energy_namespace_debug_time_offset"
+
+// debugTimeOffsetValue is the stored CloudSave value of the namespace debug time offset
+type debugTimeOffsetValue struct {
+	OffsetSeconds int64 `json:"offsetSeconds"`
+}
+
+// getIdempotencyKey returns the CloudSave key for a player's idempotency record.
+// The client key is hashed since it may contain characters CloudSave keys do not allow.
+func getIdempotencyKey(userId string, key string) string {
+	hash := sha256.Sum256([]byte(key))
+
+	return "idempotency_" + userId + "_" + hex.EncodeToString(hash[:16])
+}
+
+// createPrecondition is the updatedAt sent when creating a record. CloudSave ignores it
+// when the key is absent and rejects the write when any record exists, so concurrent
+// creates cannot overwrite each other.
+var createPrecondition = time.Unix(0, 0).UTC()
+
 // SaveEnergyData saves energy data to CloudSave
 func (c *CloudsaveStorage) SaveEnergyData(ctx context.Context, namespace string, userId string, data *EnergyData) (*EnergyData, error) {
+	precondition := data.UpdatedAt
+	if precondition.IsZero() {
+		precondition = createPrecondition
+	}
+
+	return c.saveEnergyDataConcurrent(ctx, namespace, userId, data, precondition)
+}
+
+// saveEnergyDataConcurrent writes the record only if its updatedAt still matches precondition
+func (c *CloudsaveStorage) saveEnergyDataConcurrent(ctx context.Context, namespace string, userId string, data *EnergyData, precondition time.Time) (*EnergyData, error) {
 	key := getEnergyKey(userId)
+	setBy := cloudsaveclientmodels.ModelsAdminConcurrentRecordRequestSetBySERVER
 
-	input := &admin_game_record.AdminPostGameRecordHandlerV1Params{
-		Body:      data,
+	input := &admin_concurrent_record.AdminPutGameRecordConcurrentHandlerV1Params{
+		Body: &cloudsaveclientmodels.ModelsAdminConcurrentRecordRequest{
+			SetBy:     &setBy,
+			UpdatedAt: strfmt.DateTime(precondition),
+			Value:     data,
+		},
 		Key:       key,
 		Namespace: namespace,
 		Context:   ctx,
 	}
 
-	response, err := c.csStorage.AdminPostGameRecordHandlerV1Short(input)
+	err := c.csConcurrentStorage.AdminPutGameRecordConcurrentHandlerV1Short(input)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Error saving energy data: %v", err)
-	}
+		var preconditionFailed *admin_concurrent_record.AdminPutGameRecordConcurrentHandlerV1PreconditionFailed
+		if errors.As(err, &preconditionFailed) {
+			return nil, ErrConflict
+		}
 
-	energyData, err := parseResponseToEnergyData(response)
-	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.Internal, "Error saving energy data: %v", err)
 	}
 
-	return energyData, nil
+	// The concurrent endpoint does not return the record, read it back to get the new version
+	return c.GetEnergyData(ctx, namespace, userId)
 }
 
 // GetEnergyData retrieves energy data from CloudSave
-// If no data exists, returns nil (caller should initialize)
+// If no data exists, returns ErrNotFound (caller should initialize)
 func (c *CloudsaveStorage) GetEnergyData(ctx context.Context, namespace string, userId string) (*EnergyData, error) {
 	key := getEnergyKey(userId)
 
@@ -94,9 +307,7 @@ func (c *CloudsaveStorage) GetEnergyData(ctx context.Context, namespace string,
 
 	response, err := c.csStorage.AdminGetGameRecordHandlerV1Short(input)
 	if err != nil {
-		// Check if it's a "not found" error - return nil to indicate new player
-		// The service layer will handle initialization
-		return nil, nil
+		return nil, getRecordError(err)
 	}
 
 	energyData, err := parseResponseToEnergyData(response)
@@ -107,6 +318,283 @@ func (c *CloudsaveStorage) GetEnergyData(ctx context.Context, namespace string,
 	return energyData, nil
 }
 
+// getRecordError maps a CloudSave get record error to a gRPC status error.
+// Only a 404 response is reported as ErrNotFound, so a failed read is never mistaken for a new player.
+func getRecordError(err error) error {
+	var notFound *admin_game_record.AdminGetGameRecordHandlerV1NotFound
+	if errors.As(err, &notFound) {
+		return ErrNotFound
+	}
+
+	var unauthorized *admin_game_record.AdminGetGameRecordHandlerV1Unauthorized
+	var forbidden *admin_game_record.AdminGetGameRecordHandlerV1Forbidden
+	if errors.As(err, &unauthorized) || errors.As(err, &forbidden) {
+		return status.Errorf(codes.Internal, "Error getting record: %v", err)
+	}
+
+	// 5xx responses and transport failures are transient, the client may retry
+	return status.Errorf(codes.Unavailable, "Error getting record: %v", err)
+}
+
+// idempotencyRecordValue is the stored CloudSave value, with metadata so CloudSave deletes it after expiry
+type idempotencyRecordValue struct {
+	*IdempotencyRecord
+	Meta struct {
+		SetBy     string                                    `json:"set_by"`
+		TTLConfig *cloudsaveclientmodels.ModelsTTLConfigDTO `json:"ttl_config"`
+	} `json:"__META"`
+}
+
+// SaveIdempotencyRecord saves an idempotency record to CloudSave, replacing any existing one
+func (c *CloudsaveStorage) SaveIdempotencyRecord(ctx context.Context, namespace string, userId string, key string, record *IdempotencyRecord) error {
+	action := cloudsaveclientmodels.ModelsTTLConfigDTOActionDELETE
+	value := idempotencyRecordValue{IdempotencyRecord: record}
+	value.Meta.SetBy = cloudsaveclientmodels.ModelsAdminConcurrentRecordRequestSetBySERVER
+	value.Meta.TTLConfig = &cloudsaveclientmodels.ModelsTTLConfigDTO{
+		Action:    &action,
+		ExpiresAt: strfmt.DateTime(time.Unix(record.ExpiresAt, 0).UTC()),
+	}
+
+	input := &admin_game_record.AdminPutGameRecordHandlerV1Params{
+		Body:      value,
+		Key:       getIdempotencyKey(userId, key),
+		Namespace: namespace,
+		Context:   ctx,
+	}
+
+	_, err := c.csStorage.AdminPutGameRecordHandlerV1Short(input)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Error saving idempotency record: %v", err)
+	}
+
+	return nil
+}
+
+// ClaimIdempotencyRecord saves an idempotency record to CloudSave only if the stored record
+// still matches record.UpdatedAt, or no record exists when it is zero
+func (c *CloudsaveStorage) ClaimIdempotencyRecord(ctx context.Context, namespace string, userId string, key string, record *IdempotencyRecord) error {
+	precondition := record.UpdatedAt
+	if precondition.IsZero() {
+		precondition = createPrecondition
+	}
+
+	setBy := cloudsaveclientmodels.ModelsAdminConcurrentRecordRequestSetBySERVER
+	action := cloudsaveclientmodels.ModelsTTLConfigDTOActionDELETE
+
+	input := &admin_concurrent_record.AdminPutGameRecordConcurrentHandlerV1Params{
+		Body: &cloudsaveclientmodels.ModelsAdminConcurrentRecordRequest{
+			SetBy: &setBy,
+			TTLConfig: &cloudsaveclientmodels.ModelsTTLConfigDTO{
+				Action:    &action,
+				ExpiresAt: strfmt.DateTime(time.Unix(record.ExpiresAt, 0).UTC()),
+			},
+			UpdatedAt: strfmt.DateTime(precondition),
+			Value:     record,
+		},
+		Key:       getIdempotencyKey(userId, key),
+		Namespace: namespace,
+		Context:   ctx,
+	}
+
+	err := c.csConcurrentStorage.AdminPutGameRecordConcurrentHandlerV1Short(input)
+	if err != nil {
+		var preconditionFailed *admin_concurrent_record.AdminPutGameRecordConcurrentHandlerV1PreconditionFailed
+		if errors.As(err, &preconditionFailed) {
+			return ErrConflict
+		}
+
+		return status.Errorf(codes.Internal, "Error claiming idempotency record: %v", err)
+	}
+
+	return nil
+}
+
+// DeleteIdempotencyRecord deletes an idempotency record from CloudSave
+func (c *CloudsaveStorage) DeleteIdempotencyRecord(ctx context.Context, namespace string, userId string, key string) error {
+	input := &admin_game_record.AdminDeleteGameRecordHandlerV1Params{
+		Key:       getIdempotencyKey(userId, key),
+		Namespace: namespace,
+		Context:   ctx,
+	}
+
+	err := c.csStorage.AdminDeleteGameRecordHandlerV1Short(input)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Error deleting idempotency record: %v", err)
+	}
+
+	return nil
+}
+
+// GetIdempotencyRecord retrieves an idempotency record from CloudSave
+func (c *CloudsaveStorage) GetIdempotencyRecord(ctx context.Context, namespace string, userId string, key string) (*IdempotencyRecord, error) {
+	input := &admin_game_record.AdminGetGameRecordHandlerV1Params{
+		Key:       getIdempotencyKey(userId, key),
+		Namespace: namespace,
+		Context:   ctx,
+	}
+
+	response, err := c.csStorage.AdminGetGameRecordHandlerV1Short(input)
+	if err != nil {
+		return nil, getRecordError(err)
+	}
+
+	valueJSON, err := json.Marshal(response.Value)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error marshalling value into JSON: %v", err)
+	}
+
+	var record IdempotencyRecord
+	err = json.Unmarshal(valueJSON, &record)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error unmarshalling value into IdempotencyRecord: %v", err)
+	}
+	record.UpdatedAt = time.Time(response.UpdatedAt)
+
+	return &record, nil
+}
+
+// SaveNamespaceConfig saves the namespace-wide energy config to CloudSave, replacing any existing one
+func (c *CloudsaveStorage) SaveNamespaceConfig(ctx context.Context, namespace string, config *NamespaceConfig) error {
+	input := &admin_game_record.AdminPutGameRecordHandlerV1Params{
+		Body:      config,
+		Key:       namespaceConfigKey,
+		Namespace: namespace,
+		Context:   ctx,
+	}
+
+	_, err := c.csStorage.AdminPutGameRecordHandlerV1Short(input)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Error saving namespace config: %v", err)
+	}
+
+	return nil
+}
+
+// GetNamespaceConfig retrieves the namespace-wide energy config from CloudSave
+func (c *CloudsaveStorage) GetNamespaceConfig(ctx context.Context, namespace string) (*NamespaceConfig, error) {
+	input := &admin_game_record.AdminGetGameRecordHandlerV1Params{
+		Key:       namespaceConfigKey,
+		Namespace: namespace,
+		Context:   ctx,
+	}
+
+	response, err := c.csStorage.AdminGetGameRecordHandlerV1Short(input)
+	if err != nil {
+		return nil, getRecordError(err)
+	}
+
+	valueJSON, err := json.Marshal(response.Value)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error marshalling value into JSON: %v", err)
+	}
+
+	var config NamespaceConfig
+	err = json.Unmarshal(valueJSON, &config)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error unmarshalling value into NamespaceConfig: %v", err)
+	}
+
+	return &config, nil
+}
+
+// SaveNamespaceBoosts saves the namespace-wide regen events to CloudSave, replacing the existing ones
+func (c *CloudsaveStorage) SaveNamespaceBoosts(ctx context.Context, namespace string, boosts []RegenBoost) error {
+	input := &admin_game_record.AdminPutGameRecordHandlerV1Params{
+		Body:      namespaceBoostsValue{Boosts: boosts},
+		Key:       namespaceBoostsKey,
+		Namespace: namespace,
+		Context:   ctx,
+	}
+
+	_, err := c.csStorage.AdminPutGameRecordHandlerV1Short(input)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Error saving namespace boosts: %v", err)
+	}
+
+	return nil
+}
+
+// GetNamespaceBoosts retrieves the namespace-wide regen events from CloudSave
+func (c *CloudsaveStorage) GetNamespaceBoosts(ctx context.Context, namespace string) ([]RegenBoost, error) {
+	input := &admin_game_record.AdminGetGameRecordHandlerV1Params{
+		Key:       namespaceBoostsKey,
+		Namespace: namespace,
+		Context:   ctx,
+	}
+
+	response, err := c.csStorage.AdminGetGameRecordHandlerV1Short(input)
+	if err != nil {
+		err = getRecordError(err)
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	valueJSON, err := json.Marshal(response.Value)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error marshalling value into JSON: %v", err)
+	}
+
+	var value namespaceBoostsValue
+	err = json.Unmarshal(valueJSON, &value)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error unmarshalling value into namespace boosts: %v", err)
+	}
+
+	return value.Boosts, nil
+}
+
+// SaveDebugTimeOffset saves the namespace debug time offset to CloudSave
+func (c *CloudsaveStorage) SaveDebugTimeOffset(ctx context.Context, namespace string, offsetSeconds int64) error {
+	input := &admin_game_record.AdminPutGameRecordHandlerV1Params{
+		Body:      debugTimeOffsetValue{OffsetSeconds: offsetSeconds},
+		Key:       debugTimeOffsetKey,
+		Namespace: namespace,
+		Context:   ctx,
+	}
+
+	_, err := c.csStorage.AdminPutGameRecordHandlerV1Short(input)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Error saving debug time offset: %v", err)
+	}
+
+	return nil
+}
+
+// GetDebugTimeOffset retrieves the namespace debug time offset from CloudSave
+func (c *CloudsaveStorage) GetDebugTimeOffset(ctx context.Context, namespace string) (int64, error) {
+	input := &admin_game_record.AdminGetGameRecordHandlerV1Params{
+		Key:       debugTimeOffsetKey,
+		Namespace: namespace,
+		Context:   ctx,
+	}
+
+	response, err := c.csStorage.AdminGetGameRecordHandlerV1Short(input)
+	if err != nil {
+		err = getRecordError(err)
+		if errors.Is(err, ErrNotFound) {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	valueJSON, err := json.Marshal(response.Value)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "Error marshalling value into JSON: %v", err)
+	}
+
+	var value debugTimeOffsetValue
+	err = json.Unmarshal(valueJSON, &value)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "Error unmarshalling value into debug time offset: %v", err)
+	}
+
+	return value.OffsetSeconds, nil
+}
+
 // parseResponseToEnergyData converts CloudSave response to EnergyData
 func parseResponseToEnergyData(response *cloudsaveclientmodels.ModelsGameRecordAdminResponse) (*EnergyData, error) {
 	// Convert the response value to JSON
@@ -121,6 +609,7 @@ func parseResponseToEnergyData(response *cloudsaveclientmodels.ModelsGameRecordA
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Error unmarshalling value into EnergyData: %v", err)
 	}
+	energyData.UpdatedAt = time.Time(response.UpdatedAt)
 
 	return &energyData, nil
 }